@@ -93,6 +93,8 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
+	recorderErr = initRecorder()
+
 	var err error
 	Provider, err = provider.New(context.Background())
 
@@ -200,13 +202,20 @@ func ProtoV5FactoriesMultipleRegions(t *testing.T, n int) map[string]func() (tfp
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func PreCheck(t *testing.T) {
+	if recorderErr != nil {
+		t.Fatal(recorderErr)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// No credentials are required when running against a local stand-in for AWS or replaying recorded traffic.
+		if !offline() {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -0,0 +1,79 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+)
+
+// Concurrency pools for acceptance tests creating resources with low account quotas.
+// A test acquires its pools with PreCheckPools and holds them until the test, including destroy, completes.
+var (
+	PoolClientVPN            = tfsync.NewPool("ClientVPN", envvar.EC2ClientVPNLimit, 5)
+	PoolEIP                  = tfsync.NewPool("EIP", envvar.EC2EIPLimit, 5)
+	PoolOrganizationsAccount = tfsync.NewPool("OrganizationsAccount", envvar.OrganizationsAccountLimit, 1)
+	PoolVPC                  = tfsync.NewPool("VPC", envvar.EC2VPCLimit, 5)
+)
+
+const (
+	defaultPoolWaitTimeout = 1 * time.Hour
+)
+
+// PreCheckPools waits until the test can acquire each of the specified concurrency pools.
+// The pools are released when the test completes.
+// The test is skipped if any pool's capacity is 0, and fails if the pools cannot be acquired
+// within the TF_ACC_POOL_WAIT_TIMEOUT environment variable's duration.
+func PreCheckPools(t *testing.T, pools ...*tfsync.Pool) {
+	for _, pool := range pools {
+		if pool.Capacity() == 0 {
+			t.Skipf("concurrency for %s pool set to 0", pool.Name())
+		}
+	}
+
+	timeout := defaultPoolWaitTimeout
+
+	if v := os.Getenv(envvar.AccPoolWaitTimeout); v != "" {
+		var err error
+
+		timeout, err = time.ParseDuration(v)
+
+		if err != nil {
+			t.Fatalf("parsing %s: %s", envvar.AccPoolWaitTimeout, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	release, err := tfsync.AcquirePools(ctx, pools...)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(release)
+}
+
+// RunWithPoolSummary runs the tests and then prints a summary of queue wait time per concurrency pool, if any pool was used.
+// It is intended to be called from a service package's TestMain, passing the result to os.Exit.
+func RunWithPoolSummary(m *testing.M) int {
+	code := m.Run()
+
+	var sb strings.Builder
+
+	if err := tfsync.WritePoolSummary(&sb); err != nil {
+		fmt.Fprintf(os.Stderr, "writing pool summary: %s\n", err)
+	}
+
+	if sb.Len() > 0 {
+		fmt.Printf("Acceptance test concurrency pool queue wait time:\n%s", sb.String())
+	}
+
+	return code
+}
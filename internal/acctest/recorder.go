@@ -0,0 +1,86 @@
+package acctest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/httprecorder"
+)
+
+const (
+	// Seed for pseudo-random test resource names when recording or replaying, so that names match the recording.
+	recorderRandomSeed = 1
+)
+
+// recorder records, or replays, AWS API HTTP traffic if enabled with the TF_AWS_RECORD_MODE environment variable.
+// Interactions are written to the fixture file as they are recorded, so the recorder is never closed.
+var recorder *httprecorder.Recorder
+
+// recorderErr is any error installing the recorder. Acceptance tests fail with it in PreCheck.
+var recorderErr error
+
+// initRecorder installs an AWS API HTTP traffic recorder, if enabled.
+//
+// Replaying requires the same tests to make the same requests in the same order as when recording, so both
+// must use the same -run pattern and -parallel 1. Pseudo-random test resource names are made deterministic.
+func initRecorder() error {
+	mode := os.Getenv(envvar.RecordMode)
+
+	if mode == "" {
+		return nil
+	}
+
+	path, err := envvar.Require(envvar.RecordFile, "path of the HTTP recorder fixture file")
+
+	if err != nil {
+		return err
+	}
+
+	recorder, err = httprecorder.New(httprecorder.Mode(mode), path)
+
+	if err != nil {
+		return fmt.Errorf("initializing HTTP recorder: %w", err)
+	}
+
+	if recorder.Mode() == httprecorder.ModeReplay {
+		// No credentials are required, but the AWS SDKs still sign requests.
+		if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+			os.Setenv(envvar.AccessKeyId, "replay")
+			os.Setenv(envvar.SecretAccessKey, "replay")
+		}
+	}
+
+	if err := seedRandom(); err != nil {
+		return err
+	}
+
+	conns.SetHTTPTransportWrapper(recorder.Wrap)
+
+	return nil
+}
+
+// seedRandom makes pseudo-random test resource names deterministic.
+// The Plugin SDK's acctest package draws them from math/rand's global source, which can't be replaced,
+// so that source is seeded. Recent Go releases can make seeding it a no-op, which is reported as an error.
+func seedRandom() error {
+	rand.Seed(recorderRandomSeed) //nolint:staticcheck // Determinism is required.
+	want := rand.Int63()
+
+	rand.Seed(recorderRandomSeed) //nolint:staticcheck // Determinism is required.
+	if rand.Int63() != want {
+		return errors.New("seeding math/rand has no effect, pseudo-random test resource names can't be made deterministic (set GODEBUG=randseednop=0)")
+	}
+
+	rand.Seed(recorderRandomSeed) //nolint:staticcheck // Determinism is required.
+
+	return nil
+}
+
+// offline returns whether acceptance tests run without access to AWS, against a local stand-in or replayed traffic.
+func offline() bool {
+	return os.Getenv(envvar.LocalEndpoint) != "" || (recorder != nil && recorder.Mode() == httprecorder.ModeReplay)
+}
@@ -0,0 +1,384 @@
+package attrmap
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// FrameworkAttributeMap represents a map of Terraform resource attribute name to AWS API attribute name
+// for Terraform Plugin Framework resources.
+// Attribute values are read from and written to typed resource models, structs whose fields have `tfsdk` tags.
+// Supported field types are types.Bool, types.Int64, types.String, fwtypes.IAMPolicy and fwtypes.JSON.
+type FrameworkAttributeMap map[string]attributeInfo
+
+// NewFramework returns a new FrameworkAttributeMap from the specified Terraform resource attribute name to AWS API attribute name map and resource schema.
+func NewFramework(attrMap map[string]string, resourceSchema tfsdk.Schema) FrameworkAttributeMap {
+	attributeMap := make(FrameworkAttributeMap)
+
+	for tfAttributeName, apiAttributeName := range attrMap {
+		if a, ok := resourceSchema.Attributes[tfAttributeName]; ok {
+			attributeInfo := attributeInfo{
+				apiAttributeName: apiAttributeName,
+				tfComputed:       a.Computed,
+				tfOptional:       a.Optional,
+			}
+
+			if a.Type != nil && a.Type.Equal(types.BoolType) {
+				attributeInfo.tfType = schema.TypeBool
+			}
+
+			attributeMap[tfAttributeName] = attributeInfo
+		} else {
+			log.Printf("[ERROR] Unknown attribute: %s", tfAttributeName)
+		}
+	}
+
+	return attributeMap
+}
+
+// APIAttributesToModel sets the fields of the resource model (a pointer to struct) from a map of AWS API attributes.
+// The model's existing field values are used as the prior values, e.g. to keep equivalent IAM policies.
+func (m FrameworkAttributeMap) APIAttributesToModel(apiAttributes map[string]string, model any) error {
+	fields, err := modelFields(model)
+
+	if err != nil {
+		return err
+	}
+
+	for tfAttributeName, attributeInfo := range m {
+		field, ok := fields[tfAttributeName]
+
+		if !ok {
+			return fmt.Errorf("model has no field for attribute %s", tfAttributeName)
+		}
+
+		if v, ok := apiAttributes[attributeInfo.apiAttributeName]; ok {
+			if attributeInfo.isIAMPolicy {
+				if prior, ok := stringFieldValue(field); ok {
+					policy, err := verify.PolicyToSet(prior, v)
+
+					if err != nil {
+						return err
+					}
+
+					v = policy
+				}
+			}
+
+			tfAttributeValue, err := valueFromAPIAttribute(field.Interface(), v)
+
+			if err != nil {
+				return fmt.Errorf("parsing %s value (%s): %w", tfAttributeName, v, err)
+			}
+
+			field.Set(reflect.ValueOf(tfAttributeValue))
+		} else if attributeInfo.missingSetToNil {
+			tfAttributeValue, err := nullValue(field.Interface())
+
+			if err != nil {
+				return fmt.Errorf("attribute %s: %w", tfAttributeName, err)
+			}
+
+			field.Set(reflect.ValueOf(tfAttributeValue))
+		}
+	}
+
+	return nil
+}
+
+// ModelToAPIAttributesCreate returns a map of AWS API attributes from the planned resource model (a pointer to struct).
+// The API attributes map is suitable for resource create.
+func (m FrameworkAttributeMap) ModelToAPIAttributesCreate(plan any) (map[string]string, error) {
+	fields, err := modelFields(plan)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiAttributes := map[string]string{}
+
+	for tfAttributeName, attributeInfo := range m {
+		// Purely Computed values aren't specified on creation.
+		if attributeInfo.tfComputed && !attributeInfo.tfOptional {
+			continue
+		}
+
+		field, ok := fields[tfAttributeName]
+
+		if !ok {
+			return nil, fmt.Errorf("model has no field for attribute %s", tfAttributeName)
+		}
+
+		var apiAttributeValue string
+		tfOptionalComputed := attributeInfo.tfComputed && attributeInfo.tfOptional
+
+		switch v := field.Interface().(type) {
+		case types.Bool:
+			// Unknown values are those not configured for Optional/Computed attributes.
+			if v.IsUnknown() || v.IsNull() {
+				continue
+			}
+
+			if v.Value || attributeInfo.alwaysSendConfiguredValueOnCreate {
+				apiAttributeValue = strconv.FormatBool(v.Value)
+			}
+		case types.Int64:
+			if v.IsUnknown() || v.IsNull() {
+				continue
+			}
+
+			// On creation don't specify any zero Optional/Computed attribute integer values.
+			if !tfOptionalComputed || v.Value != 0 {
+				apiAttributeValue = strconv.FormatInt(v.Value, 10)
+			}
+		case types.String, fwtypes.IAMPolicy, fwtypes.JSON:
+			s, ok := stringFieldValue(field)
+
+			if !ok {
+				continue
+			}
+
+			apiAttributeValue = s
+
+			if attributeInfo.isIAMPolicy && apiAttributeValue != "" {
+				policy, err := structure.NormalizeJsonString(apiAttributeValue)
+
+				if err != nil {
+					return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
+				}
+
+				apiAttributeValue = policy
+			}
+		default:
+			return nil, fmt.Errorf("attribute %s is of unsupported type: %T", tfAttributeName, v)
+		}
+
+		if apiAttributeValue != "" {
+			apiAttributes[attributeInfo.apiAttributeName] = apiAttributeValue
+		}
+	}
+
+	return apiAttributes, nil
+}
+
+// ModelToAPIAttributesUpdate returns a map of AWS API attributes from the planned and prior state resource models (pointers to struct).
+// Only attributes whose planned value differs from the prior state value are included.
+// IAM policies that are equivalent to the prior state value are not included.
+// The API attributes map is suitable for resource update.
+func (m FrameworkAttributeMap) ModelToAPIAttributesUpdate(plan, state any) (map[string]string, error) {
+	planFields, err := modelFields(plan)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stateFields, err := modelFields(state)
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiAttributes := map[string]string{}
+
+	for tfAttributeName, attributeInfo := range m {
+		if attributeInfo.skipUpdate {
+			continue
+		}
+
+		// Purely Computed values aren't specified on update.
+		if attributeInfo.tfComputed && !attributeInfo.tfOptional {
+			continue
+		}
+
+		planField, ok := planFields[tfAttributeName]
+
+		if !ok {
+			return nil, fmt.Errorf("model has no field for attribute %s", tfAttributeName)
+		}
+
+		stateField, ok := stateFields[tfAttributeName]
+
+		if !ok {
+			return nil, fmt.Errorf("model has no field for attribute %s", tfAttributeName)
+		}
+
+		planValue, ok := planField.Interface().(attr.Value)
+
+		if !ok {
+			return nil, fmt.Errorf("attribute %s is of unsupported type: %T", tfAttributeName, planField.Interface())
+		}
+
+		stateValue, _ := stateField.Interface().(attr.Value)
+
+		// Unknown values are those not configured for Optional/Computed attributes.
+		if planValue.IsUnknown() || planValue.Equal(stateValue) {
+			continue
+		}
+
+		var apiAttributeValue string
+
+		switch v := planValue.(type) {
+		case types.Bool:
+			apiAttributeValue = strconv.FormatBool(v.Value)
+		case types.Int64:
+			apiAttributeValue = strconv.FormatInt(v.Value, 10)
+		case types.String, fwtypes.IAMPolicy, fwtypes.JSON:
+			apiAttributeValue, _ = stringFieldValue(planField)
+
+			if attributeInfo.isIAMPolicy {
+				if prior, ok := stringFieldValue(stateField); ok && verify.SuppressEquivalentPolicyDiffs(tfAttributeName, prior, apiAttributeValue, nil) {
+					continue
+				}
+
+				policy, err := structure.NormalizeJsonString(apiAttributeValue)
+
+				if err != nil {
+					return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
+				}
+
+				apiAttributeValue = policy
+			}
+		default:
+			return nil, fmt.Errorf("attribute %s is of unsupported type: %T", tfAttributeName, v)
+		}
+
+		apiAttributes[attributeInfo.apiAttributeName] = apiAttributeValue
+	}
+
+	return apiAttributes, nil
+}
+
+// APIAttributeNames returns the AWS API attribute names.
+func (m FrameworkAttributeMap) APIAttributeNames() []string {
+	return AttributeMap(m).APIAttributeNames()
+}
+
+// WithAlwaysSendConfiguredBooleanValueOnCreate marks the specified Terraform Boolean attribute as always having any configured value sent on resource create.
+// By default a Boolean value is only sent to the API on resource create if its configured value is true.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m FrameworkAttributeMap) WithAlwaysSendConfiguredBooleanValueOnCreate(tfAttributeName string) FrameworkAttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok && attributeInfo.tfType == schema.TypeBool {
+		attributeInfo.alwaysSendConfiguredValueOnCreate = true
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithIAMPolicyAttribute marks the specified Terraform attribute as holding an AWS IAM policy.
+// AWS IAM policies get special handling.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m FrameworkAttributeMap) WithIAMPolicyAttribute(tfAttributeName string) FrameworkAttributeMap {
+	AttributeMap(m).WithIAMPolicyAttribute(tfAttributeName)
+
+	return m
+}
+
+// WithMissingSetToNil marks the specified Terraform attribute as being set to null if it's missing after reading the API.
+// An attribute name of "*" means all attributes get marked.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m FrameworkAttributeMap) WithMissingSetToNil(tfAttributeName string) FrameworkAttributeMap {
+	AttributeMap(m).WithMissingSetToNil(tfAttributeName)
+
+	return m
+}
+
+// WithSkipUpdate marks the specified Terraform attribute as skipping update handling.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m FrameworkAttributeMap) WithSkipUpdate(tfAttributeName string) FrameworkAttributeMap {
+	AttributeMap(m).WithSkipUpdate(tfAttributeName)
+
+	return m
+}
+
+// modelFields returns the settable fields of the specified pointer to struct, keyed by `tfsdk` tag.
+func modelFields(model any) (map[string]reflect.Value, error) {
+	v := reflect.ValueOf(model)
+
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("model must be a pointer to struct, got %T", model)
+	}
+
+	v = v.Elem()
+	fields := make(map[string]reflect.Value)
+
+	for i, t := 0, v.Type(); i < t.NumField(); i++ {
+		if tag := t.Field(i).Tag.Get("tfsdk"); tag != "" && tag != "-" {
+			fields[tag] = v.Field(i)
+		}
+	}
+
+	return fields, nil
+}
+
+// stringFieldValue returns the value of a known, non-null string-based field.
+func stringFieldValue(field reflect.Value) (string, bool) {
+	switch v := field.Interface().(type) {
+	case types.String:
+		return v.Value, !v.IsUnknown() && !v.IsNull()
+	case fwtypes.IAMPolicy:
+		return v.Value, !v.IsUnknown() && !v.IsNull()
+	case fwtypes.JSON:
+		return v.Value, !v.IsUnknown() && !v.IsNull()
+	default:
+		return "", false
+	}
+}
+
+// valueFromAPIAttribute returns a value of the same type as `v` from the specified AWS API attribute value.
+func valueFromAPIAttribute(v any, s string) (attr.Value, error) {
+	switch v.(type) {
+	case types.Bool:
+		b, err := strconv.ParseBool(s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.Bool{Value: b}, nil
+	case types.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.Int64{Value: i}, nil
+	case types.String:
+		return types.String{Value: s}, nil
+	case fwtypes.IAMPolicy:
+		return fwtypes.IAMPolicy{Value: s}, nil
+	case fwtypes.JSON:
+		return fwtypes.JSON{Value: s}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
+
+// nullValue returns a null value of the same type as `v`.
+func nullValue(v any) (attr.Value, error) {
+	switch v.(type) {
+	case types.Bool:
+		return types.Bool{Null: true}, nil
+	case types.Int64:
+		return types.Int64{Null: true}, nil
+	case types.String:
+		return types.String{Null: true}, nil
+	case fwtypes.IAMPolicy:
+		return fwtypes.IAMPolicy{Null: true}, nil
+	case fwtypes.JSON:
+		return fwtypes.JSON{Null: true}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
@@ -0,0 +1,168 @@
+package attrmap_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+type testModel struct {
+	ARN                  types.String      `tfsdk:"arn"`
+	DelaySeconds         types.Int64       `tfsdk:"delay_seconds"`
+	FIFOQueue            types.Bool        `tfsdk:"fifo_queue"`
+	ID                   types.String      `tfsdk:"id"`
+	MaxMessageSize       types.Int64       `tfsdk:"max_message_size"`
+	Name                 types.String      `tfsdk:"name"`
+	Policy               fwtypes.IAMPolicy `tfsdk:"policy"`
+	SQSManagedSSEEnabled types.Bool        `tfsdk:"sqs_managed_sse_enabled"`
+}
+
+func testAttributeMap() attrmap.FrameworkAttributeMap {
+	return attrmap.NewFramework(map[string]string{
+		"arn":                     "QueueArn",
+		"delay_seconds":           "DelaySeconds",
+		"fifo_queue":              "FifoQueue",
+		"max_message_size":        "MaximumMessageSize",
+		"name":                    "Name",
+		"policy":                  "Policy",
+		"sqs_managed_sse_enabled": "SqsManagedSseEnabled",
+	}, tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"arn":                     {Type: types.StringType, Computed: true},
+			"delay_seconds":           {Type: types.Int64Type, Optional: true},
+			"fifo_queue":              {Type: types.BoolType, Optional: true},
+			"max_message_size":        {Type: types.Int64Type, Optional: true, Computed: true},
+			"name":                    {Type: types.StringType, Required: true},
+			"policy":                  {Type: fwtypes.IAMPolicyType, Optional: true, Computed: true},
+			"sqs_managed_sse_enabled": {Type: types.BoolType, Optional: true, Computed: true},
+		},
+	}).
+		WithAlwaysSendConfiguredBooleanValueOnCreate("sqs_managed_sse_enabled").
+		WithIAMPolicyAttribute("policy").
+		WithMissingSetToNil("*").
+		WithSkipUpdate("name")
+}
+
+const (
+	testPolicy           = `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+	testPolicyEquivalent = `{"Statement":[{"Action":["sqs:SendMessage"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+)
+
+func TestFrameworkAttributeMapModelToAPIAttributesCreate(t *testing.T) {
+	t.Parallel()
+
+	plan := testModel{
+		ARN:                  types.String{Unknown: true},
+		DelaySeconds:         types.Int64{Value: 0},
+		FIFOQueue:            types.Bool{Value: false},
+		MaxMessageSize:       types.Int64{Unknown: true},
+		Name:                 types.String{Value: "test"},
+		Policy:               fwtypes.IAMPolicy{Value: testPolicy},
+		SQSManagedSSEEnabled: types.Bool{Value: false},
+	}
+
+	got, err := testAttributeMap().ModelToAPIAttributesCreate(&plan)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"DelaySeconds":         "0",
+		"Name":                 "test",
+		"Policy":               testPolicy,
+		"SqsManagedSseEnabled": "false",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFrameworkAttributeMapModelToAPIAttributesUpdate(t *testing.T) {
+	t.Parallel()
+
+	state := testModel{
+		ARN:                  types.String{Value: "arn:aws:sqs:us-west-2:123456789012:test"},
+		DelaySeconds:         types.Int64{Value: 0},
+		FIFOQueue:            types.Bool{Value: false},
+		MaxMessageSize:       types.Int64{Value: 262144},
+		Name:                 types.String{Value: "test"},
+		Policy:               fwtypes.IAMPolicy{Value: testPolicy},
+		SQSManagedSSEEnabled: types.Bool{Value: true},
+	}
+	plan := state
+	plan.DelaySeconds = types.Int64{Value: 90}
+	plan.Name = types.String{Value: "test2"}
+	plan.Policy = fwtypes.IAMPolicy{Value: testPolicyEquivalent}
+	plan.SQSManagedSSEEnabled = types.Bool{Value: false}
+
+	got, err := testAttributeMap().ModelToAPIAttributesUpdate(&plan, &state)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"DelaySeconds":         "90",
+		"SqsManagedSseEnabled": "false",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFrameworkAttributeMapAPIAttributesToModel(t *testing.T) {
+	t.Parallel()
+
+	model := testModel{
+		ID:     types.String{Value: "https://sqs.us-west-2.amazonaws.com/123456789012/test"},
+		Policy: fwtypes.IAMPolicy{Value: testPolicyEquivalent},
+	}
+
+	err := testAttributeMap().APIAttributesToModel(map[string]string{
+		"DelaySeconds":       "90",
+		"FifoQueue":          "true",
+		"MaximumMessageSize": "262144",
+		"Policy":             testPolicy,
+		"QueueArn":           "arn:aws:sqs:us-west-2:123456789012:test",
+	}, &model)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := testModel{
+		ARN:                  types.String{Value: "arn:aws:sqs:us-west-2:123456789012:test"},
+		DelaySeconds:         types.Int64{Value: 90},
+		FIFOQueue:            types.Bool{Value: true},
+		ID:                   types.String{Value: "https://sqs.us-west-2.amazonaws.com/123456789012/test"},
+		MaxMessageSize:       types.Int64{Value: 262144},
+		Name:                 types.String{Null: true},
+		Policy:               fwtypes.IAMPolicy{Value: testPolicyEquivalent},
+		SQSManagedSSEEnabled: types.Bool{Null: true},
+	}
+
+	if diff := cmp.Diff(model, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFrameworkAttributeMapAPIAttributesToModel_invalid(t *testing.T) {
+	t.Parallel()
+
+	var model testModel
+
+	if err := testAttributeMap().APIAttributesToModel(map[string]string{"DelaySeconds": "ninety"}, &model); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+
+	if err := testAttributeMap().APIAttributesToModel(map[string]string{}, model); err == nil {
+		t.Fatal("expected error for non-pointer model, got no error")
+	}
+}
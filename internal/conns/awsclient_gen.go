@@ -319,6 +319,7 @@ type AWSClient struct {
 	IgnoreTagsConfig          *tftags.IgnoreConfig
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
+	PGPKey                    string
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
@@ -68,7 +68,9 @@ type Config struct {
 	HTTPProxy                      string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LocalEndpoint                  string
 	MaxRetries                     int
+	PGPKey                         string
 	Profile                        string
 	Region                         string
 	S3UsePathStyle                 bool
@@ -87,8 +89,21 @@ type Config struct {
 	UseFIPSEndpoint                bool
 }
 
+const (
+	// LocalAccountID is the synthetic AWS account ID used in local mode.
+	LocalAccountID = "000000000000"
+
+	// Static credentials used in local mode if none are configured.
+	localAccessKey = "test"
+	localSecretKey = "test"
+)
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
+	if c.LocalEndpoint != "" {
+		c.configureLocalMode()
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		APNInfo:                       StdUserAgentProducts(c.TerraformVersion),
@@ -154,11 +169,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	wrapHTTPClients(&cfg, sess)
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
 	}
 
+	if c.LocalEndpoint != "" && accountID == "" {
+		accountID = LocalAccountID
+	}
+
 	if accountID == "" {
 		log.Println("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -196,6 +217,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
+	client.PGPKey = c.PGPKey
 	client.Region = c.Region
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
@@ -604,3 +626,31 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	return client, nil
 }
+
+// configureLocalMode configures the provider to use a local stand-in for AWS, such as LocalStack or a
+// recorded traffic server, at LocalEndpoint. Every service endpoint not explicitly configured uses
+// LocalEndpoint, static test credentials are used if none are configured, and account and region
+// validation and EC2 instance metadata lookups are skipped.
+func (c *Config) configureLocalMode() {
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	for _, pkg := range names.ProviderPackages() {
+		if c.Endpoints[pkg] == "" {
+			c.Endpoints[pkg] = c.LocalEndpoint
+		}
+	}
+
+	if c.AccessKey == "" && c.Profile == "" {
+		c.AccessKey = localAccessKey
+		c.SecretKey = localSecretKey
+	}
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipGetEC2Platforms = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+}
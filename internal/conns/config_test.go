@@ -0,0 +1,95 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigureProviderLocalMode(t *testing.T) {
+	var requests, wrapped int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	SetHTTPTransportWrapper(func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&wrapped, 1)
+
+			return next.RoundTrip(req)
+		})
+	})
+	defer SetHTTPTransportWrapper(nil)
+
+	c := &Config{
+		Endpoints: map[string]string{
+			names.SQS: server.URL + "/sqs",
+		},
+		LocalEndpoint:    server.URL,
+		Region:           endpoints.UsWest2RegionID,
+		SuppressDebugLog: true,
+	}
+
+	client, diags := c.ConfigureProvider(context.Background(), &AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// No requests are made to validate credentials or determine the account.
+	if got := atomic.LoadInt32(&requests); got != 0 {
+		t.Errorf("requests during configuration: got %d, want 0", got)
+	}
+
+	if got, want := client.AccountID, LocalAccountID; got != want {
+		t.Errorf("AccountID: got %q, want %q", got, want)
+	}
+
+	if got, want := client.Partition, endpoints.AwsPartitionID; got != want {
+		t.Errorf("Partition: got %q, want %q", got, want)
+	}
+
+	if got, want := aws.StringValue(client.S3Conn.Config.Endpoint), server.URL; got != want {
+		t.Errorf("S3 endpoint: got %q, want %q", got, want)
+	}
+
+	if got, want := aws.BoolValue(client.S3Conn.Config.S3ForcePathStyle), true; got != want {
+		t.Errorf("S3 path style: got %t, want %t", got, want)
+	}
+
+	// Explicitly configured endpoints are not overridden.
+	if got, want := client.SQSConn.Endpoint, server.URL+"/sqs"; got != want {
+		t.Errorf("SQS endpoint: got %q, want %q", got, want)
+	}
+
+	if _, err := client.SQSConn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("requests: got %d, want 1", got)
+	}
+
+	if got := atomic.LoadInt32(&wrapped); got != 1 {
+		t.Errorf("wrapped requests: got %d, want 1", got)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
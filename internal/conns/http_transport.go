@@ -0,0 +1,82 @@
+package conns
+
+import (
+	"net/http"
+	"sync"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// HTTPTransportWrapperFunc wraps the HTTP transport used by AWS SDK clients.
+type HTTPTransportWrapperFunc func(http.RoundTripper) http.RoundTripper
+
+var (
+	httpTransportWrapperLock sync.RWMutex
+	httpTransportWrapper     HTTPTransportWrapperFunc
+)
+
+// SetHTTPTransportWrapper sets a function that wraps the HTTP transport of all AWS SDK clients
+// subsequently configured in this process, for example to record or replay acceptance test traffic.
+// A nil value removes any wrapper.
+func SetHTTPTransportWrapper(f HTTPTransportWrapperFunc) {
+	httpTransportWrapperLock.Lock()
+	defer httpTransportWrapperLock.Unlock()
+
+	httpTransportWrapper = f
+}
+
+func getHTTPTransportWrapper() HTTPTransportWrapperFunc {
+	httpTransportWrapperLock.RLock()
+	defer httpTransportWrapperLock.RUnlock()
+
+	return httpTransportWrapper
+}
+
+// wrapHTTPClients applies any HTTP transport wrapper to the AWS SDK for Go v2 configuration and v1 session.
+func wrapHTTPClients(cfg *awsv2.Config, sess *session.Session) {
+	wrapper := getHTTPTransportWrapper()
+
+	if wrapper == nil {
+		return
+	}
+
+	if cfg != nil && cfg.HTTPClient != nil {
+		cfg.HTTPClient = &http.Client{
+			Transport: wrapper(doerRoundTripper{cfg.HTTPClient}),
+			// Redirects are handled by the wrapped client.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	if sess != nil {
+		httpClient := sess.Config.HTTPClient
+
+		if httpClient == nil {
+			httpClient = &http.Client{}
+		} else {
+			// Don't modify a client that may be shared.
+			v := *httpClient
+			httpClient = &v
+		}
+
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		httpClient.Transport = wrapper(transport)
+
+		sess.Config.HTTPClient = httpClient
+	}
+}
+
+// doerRoundTripper adapts an AWS SDK for Go v2 HTTP client to an http.RoundTripper.
+type doerRoundTripper struct {
+	client awsv2.HTTPClient
+}
+
+func (rt doerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.client.Do(req)
+}
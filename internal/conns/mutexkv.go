@@ -1,51 +1,350 @@
 package conns
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = NewMutexKV()
 
+const (
+	// DefaultMutexKVWaitThreshold is the default time a caller waits for a key's lock before held locks are reported.
+	DefaultMutexKVWaitThreshold = 2 * time.Minute
+)
+
 // MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each key's lock is a read/write lock. Lock and LockContext take the lock exclusively,
+// RLock and RLockContext take it shared, returning a token that identifies the reader to RUnlock.
+// A key's entry is removed once no caller holds or waits for its lock.
+//
+// The holders of, and waiters for, each key's lock are tracked by caller location.
+// If a caller waits longer than the wait threshold for a key's lock, all held keys and their waiters are logged.
 type MutexKV struct {
-	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	lock          sync.Mutex
+	store         map[string]*mutexKVEntry
+	waitThreshold time.Duration
+	report        func(string)
+}
+
+// mutexKVEntry is the state of a single key's lock.
+type mutexKVEntry struct {
+	refs           int                         // Number of callers holding or waiting for the lock.
+	writer         *mutexKVHolder              // The exclusive holder, if any.
+	readers        []*mutexKVHolder            // The shared holders, if any.
+	waiters        map[*mutexKVHolder]struct{} // Callers waiting for the lock.
+	writersWaiting int                         // Number of waiters wanting the lock exclusively.
+	released       chan struct{}               // Closed, and replaced, each time the lock is released.
+}
+
+// mutexKVHolder describes a caller holding or waiting for a key's lock.
+type mutexKVHolder struct {
+	caller    string        // e.g. ec2/vpc_security_group_rule.go:123
+	exclusive bool          // Whether the lock is (to be) held exclusively.
+	since     time.Time     // When the caller started waiting, or acquired the lock.
+	waited    time.Duration // How long the caller waited to acquire the lock.
+}
+
+// MutexKVReadToken identifies a caller holding a key's lock for reading.
+// It is returned by RLock and RLockContext and must be passed to RUnlock.
+type MutexKVReadToken struct {
+	holder *mutexKVHolder
+}
+
+// MutexKVOptionsFunc configures a MutexKV.
+type MutexKVOptionsFunc func(*MutexKV)
+
+// WithWaitThreshold sets the time a caller waits for a key's lock before held locks are reported.
+// A zero value disables reporting.
+func WithWaitThreshold(threshold time.Duration) MutexKVOptionsFunc {
+	return func(m *MutexKV) {
+		m.waitThreshold = threshold
+	}
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	m.acquire(context.Background(), key, true) //nolint:errcheck // Background context is never canceled.
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is available or the context is done.
+// If the context is done before the lock is acquired, the context's error is returned and the lock is not held.
+// Otherwise the caller is responsible for calling Unlock for the same key.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
+	_, err := m.acquire(ctx, key, true)
+
+	return err
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
+	m.release(key, nil)
+}
+
+// RLock locks the mutex for the given key for reading. Any number of callers may hold the lock for reading
+// as long as no caller holds, or is waiting for, the lock exclusively. Caller is responsible for calling RUnlock
+// for the same key with the returned token.
+func (m *MutexKV) RLock(key string) MutexKVReadToken {
+	holder, _ := m.acquire(context.Background(), key, false) // Background context is never canceled.
+
+	return MutexKVReadToken{holder: holder}
+}
+
+// RLockContext locks the mutex for the given key for reading, waiting until the lock is available or the context is done.
+// If the context is done before the lock is acquired, the context's error is returned and the lock is not held.
+// Otherwise the caller is responsible for calling RUnlock for the same key with the returned token.
+func (m *MutexKV) RLockContext(ctx context.Context, key string) (MutexKVReadToken, error) {
+	holder, err := m.acquire(ctx, key, false)
+
+	if err != nil {
+		return MutexKVReadToken{}, err
+	}
+
+	return MutexKVReadToken{holder: holder}, nil
+}
+
+// RUnlock unlocks the mutex for the given key for reading. Caller must have called RLock for the same key first,
+// and passes the token it returned.
+func (m *MutexKV) RUnlock(key string, token MutexKVReadToken) {
+	if token.holder == nil {
+		panic(fmt.Sprintf("conns: RUnlock of key %q with invalid token", key))
+	}
+
+	m.release(key, token.holder)
+}
+
+// acquire waits for the given key's lock and returns its holder.
+func (m *MutexKV) acquire(ctx context.Context, key string, exclusive bool) (*mutexKVHolder, error) {
+	holder := &mutexKVHolder{
+		caller:    caller(3),
+		exclusive: exclusive,
+		since:     time.Now(),
+	}
+
+	log.Printf("[DEBUG] Locking %q", key)
+
+	m.lock.Lock()
+
+	entry := m.get(key)
+	entry.refs++
+	entry.waiters[holder] = struct{}{}
+	if exclusive {
+		entry.writersWaiting++
+	}
+
+	var thresholdC <-chan time.Time
+	if m.waitThreshold > 0 {
+		timer := time.NewTimer(m.waitThreshold)
+		defer timer.Stop()
+		thresholdC = timer.C
+	}
+
+	for !entry.available(exclusive) {
+		released := entry.released
+		m.lock.Unlock()
+
+		select {
+		case <-released:
+			m.lock.Lock()
+
+		case <-thresholdC:
+			thresholdC = nil
+			m.lock.Lock()
+			m.report(fmt.Sprintf("[WARN] %s has waited %s for lock on %q. Held locks:\n%s", holder.caller, time.Since(holder.since).Round(time.Millisecond), key, m.dump()))
+
+		case <-ctx.Done():
+			m.lock.Lock()
+			delete(entry.waiters, holder)
+			if exclusive {
+				entry.writersWaiting--
+				// Shared waiters may have been held back by this waiter.
+				entry.signal()
+			}
+			m.put(key, entry)
+			m.lock.Unlock()
+
+			log.Printf("[DEBUG] Gave up locking %q: %s", key, ctx.Err())
+
+			return nil, ctx.Err()
+		}
+	}
+
+	delete(entry.waiters, holder)
+	now := time.Now()
+	holder.waited = now.Sub(holder.since)
+	holder.since = now
+	if exclusive {
+		entry.writersWaiting--
+		entry.writer = holder
+	} else {
+		entry.readers = append(entry.readers, holder)
+	}
+
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Locked %q after %s", key, holder.waited)
+
+	return holder, nil
+}
+
+// release releases the given key's lock, held exclusively if reader is nil or else held shared by reader.
+func (m *MutexKV) release(key string, reader *mutexKVHolder) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+
+	entry, ok := m.store[key]
+
+	if reader == nil {
+		if !ok || entry.writer == nil {
+			m.lock.Unlock()
+			panic(fmt.Sprintf("conns: Unlock of unlocked key %q", key))
+		}
+
+		entry.writer = nil
+	} else {
+		i := -1
+		if ok {
+			for j, v := range entry.readers {
+				if v == reader {
+					i = j
+					break
+				}
+			}
+		}
+
+		if i < 0 {
+			m.lock.Unlock()
+			panic(fmt.Sprintf("conns: RUnlock of key %q not read locked by token", key))
+		}
+
+		entry.readers = append(entry.readers[:i], entry.readers[i+1:]...)
+	}
+	entry.signal()
+	m.put(key, entry)
+
+	m.lock.Unlock()
+
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
-	m.lock.Lock()
-	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+// Returns the entry for the given key, no guarantee of its lock status.
+// The MutexKV's lock must be held.
+func (m *MutexKV) get(key string) *mutexKVEntry {
+	entry, ok := m.store[key]
+	if !ok {
+		entry = &mutexKVEntry{
+			waiters:  make(map[*mutexKVHolder]struct{}),
+			released: make(chan struct{}),
+		}
+		m.store[key] = entry
+	}
+	return entry
+}
+
+// Releases a caller's reference to the entry for the given key, removing the entry if it is unused.
+// The MutexKV's lock must be held.
+func (m *MutexKV) put(key string, entry *mutexKVEntry) {
+	entry.refs--
+	if entry.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// dump returns a description of all held keys and their waiters, sorted by key.
+// The MutexKV's lock must be held.
+func (m *MutexKV) dump() string {
+	keys := make([]string, 0, len(m.store))
+	for key := range m.store {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	now := time.Now()
+	var sb strings.Builder
+
+	for _, key := range keys {
+		entry := m.store[key]
+
+		fmt.Fprintf(&sb, "  %q:\n", key)
+
+		if v := entry.writer; v != nil {
+			fmt.Fprintf(&sb, "    held exclusively by %s for %s (waited %s)\n", v.caller, now.Sub(v.since).Round(time.Millisecond), v.waited.Round(time.Millisecond))
+		}
+		for _, v := range entry.readers {
+			fmt.Fprintf(&sb, "    held shared by %s for %s (waited %s)\n", v.caller, now.Sub(v.since).Round(time.Millisecond), v.waited.Round(time.Millisecond))
+		}
+
+		waiters := make([]*mutexKVHolder, 0, len(entry.waiters))
+		for v := range entry.waiters {
+			waiters = append(waiters, v)
+		}
+		sort.Slice(waiters, func(i, j int) bool {
+			return waiters[i].since.Before(waiters[j].since)
+		})
+		for _, v := range waiters {
+			mode := "shared"
+			if v.exclusive {
+				mode = "exclusively"
+			}
+			fmt.Fprintf(&sb, "    wanted %s by %s for %s\n", mode, v.caller, now.Sub(v.since).Round(time.Millisecond))
+		}
+	}
+
+	return sb.String()
+}
+
+// available returns whether the lock can be acquired.
+// Shared holders are held back while any caller waits for the lock exclusively, so that writers are not starved.
+func (e *mutexKVEntry) available(exclusive bool) bool {
+	if exclusive {
+		return e.writer == nil && len(e.readers) == 0
+	}
+
+	return e.writer == nil && e.writersWaiting == 0
+}
+
+// signal wakes all waiters so that they re-check the lock's availability.
+func (e *mutexKVEntry) signal() {
+	close(e.released)
+	e.released = make(chan struct{})
+}
+
+// caller returns the source location, relative to its package's parent directory, of the function skip frames up the stack.
+func caller(skip int) string {
+	_, file, line, ok := runtime.Caller(skip)
+
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		return "unknown"
 	}
-	return mutex
+
+	return fmt.Sprintf("%s:%d", filepath.Join(filepath.Base(filepath.Dir(file)), filepath.Base(file)), line)
 }
 
 // Returns a properly initialized MutexKV
-func NewMutexKV() *MutexKV {
-	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+func NewMutexKV(optFns ...MutexKVOptionsFunc) *MutexKV {
+	m := &MutexKV{
+		store:         make(map[string]*mutexKVEntry),
+		waitThreshold: DefaultMutexKVWaitThreshold,
+		report: func(s string) {
+			log.Print(s)
+		},
 	}
+
+	for _, fn := range optFns {
+		fn(m)
+	}
+
+	return m
 }
@@ -1,6 +1,9 @@
 package conns
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -65,3 +68,189 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContextCanceled(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLock(t *testing.T) {
+	mkv := NewMutexKV()
+
+	token1 := mkv.RLock("foo")
+
+	tokenCh := make(chan MutexKVReadToken)
+
+	go func() {
+		tokenCh <- mkv.RLock("foo")
+	}()
+
+	var token2 MutexKVReadToken
+
+	select {
+	case token2 = <-tokenCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second read lock blocked. This shouldn't happen.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.LockContext(ctx, "foo"); err == nil {
+		t.Fatal("Lock was able to be taken while read locks are held. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo", token1)
+	mkv.RUnlock("foo", token2)
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVRLockWriterWaiting(t *testing.T) {
+	mkv := NewMutexKV()
+
+	token := mkv.RLock("foo")
+
+	lockedCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(lockedCh)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := mkv.RLockContext(ctx, "foo"); err == nil {
+		t.Fatal("Read lock was able to be taken while a lock is waiting. This shouldn't happen.")
+	}
+
+	mkv.RUnlock("foo", token)
+
+	select {
+	case <-lockedCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Lock blocked after read unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVEntriesRemoved(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+	token := mkv.RLock("bar")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	mkv.LockContext(ctx, "foo") //nolint:errcheck
+
+	if got, want := len(mkv.store), 2; got != want {
+		t.Fatalf("expected %d entries, got %d", want, got)
+	}
+
+	mkv.Unlock("foo")
+	mkv.RUnlock("bar", token)
+
+	if got, want := len(mkv.store), 0; got != want {
+		t.Fatalf("expected %d entries, got %d", want, got)
+	}
+}
+
+func TestMutexKVUnlockOfUnlockedKey(t *testing.T) {
+	mkv := NewMutexKV()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Unlock of unlocked key didn't panic. This shouldn't happen.")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
+
+func TestMutexKVRUnlockByToken(t *testing.T) {
+	mkv := NewMutexKV(WithWaitThreshold(0))
+
+	token1 := mkv.RLock("foo")
+	token2 := mkv.RLock("foo")
+
+	mkv.RUnlock("foo", token2)
+
+	if got, want := mkv.store["foo"].readers, []*mutexKVHolder{token1.holder}; len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("expected only the first reader to hold the lock, got %v", got)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("RUnlock with a released token didn't panic. This shouldn't happen.")
+			}
+		}()
+
+		mkv.RUnlock("foo", token2)
+	}()
+
+	mkv.RUnlock("foo", token1)
+
+	if got, want := len(mkv.store), 0; got != want {
+		t.Fatalf("expected %d entries, got %d", want, got)
+	}
+}
+
+func TestMutexKVWaitThreshold(t *testing.T) {
+	mkv := NewMutexKV(WithWaitThreshold(20 * time.Millisecond))
+
+	reportCh := make(chan string, 1)
+	mkv.report = func(s string) {
+		reportCh <- s
+	}
+
+	mkv.Lock("foo")
+	mkv.RLock("bar")
+
+	go func() {
+		mkv.Lock("foo")
+	}()
+
+	var report string
+
+	select {
+	case report = <-reportCh:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("Held locks not reported. This shouldn't happen.")
+	}
+
+	for _, want := range []string{
+		`for lock on "foo"`,
+		`"bar":`,
+		"held shared by conns/mutexkv_test.go:",
+		`"foo":`,
+		"held exclusively by conns/mutexkv_test.go:",
+		"wanted exclusively by conns/mutexkv_test.go:",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package deprecation is the registry of deprecated provider, resource and data source arguments.
+//
+// Each entry records when the argument will be removed and what replaces it, so that
+// the schema's Deprecated message, the plan-time warning Terraform shows for it and
+// the migration hints reported for existing configurations are consistent.
+package deprecation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindDataSource Kind = "data source"
+	KindProvider   Kind = "provider"
+	KindResource   Kind = "resource"
+)
+
+// Deprecation is a deprecated argument or attribute.
+type Deprecation struct {
+	Kind Kind
+	// TypeName is the resource or data source type, e.g. aws_s3_bucket. Blank for provider arguments.
+	TypeName string
+	// Attribute is the argument's path, e.g. acl or assume_role.duration_seconds.
+	Attribute string
+	// RemovalVersion is the major version of the provider that will remove the argument, e.g. 5.0.0.
+	RemovalVersion string
+
+	// ReplacementAttribute is the argument of the same resource, data source or provider to use instead.
+	ReplacementAttribute string
+	// ReplacementResource is the resource or data source type to use instead, e.g. aws_s3_bucket_acl.
+	ReplacementResource string
+	// ReplacementResourceAttribute is the attribute of ReplacementResource to use instead, e.g. website_endpoint.
+	ReplacementResourceAttribute string
+	// ImportID is ReplacementResource's import ID in terms of the deprecated resource's arguments, e.g. {bucket},{acl}.
+	ImportID string
+}
+
+// Message returns the schema's Deprecated message, shown in Terraform's plan-time warning.
+func (d Deprecation) Message() string {
+	var b strings.Builder
+
+	switch replacementKind := d.replacementKind(); {
+	case d.ReplacementResource != "" && d.ReplacementResourceAttribute != "":
+		fmt.Fprintf(&b, "Use the %s attribute of the %s %s instead.", d.ReplacementResourceAttribute, d.ReplacementResource, replacementKind)
+	case d.ReplacementResource != "" && d.ReplacementAttribute != "":
+		fmt.Fprintf(&b, "Use the top-level %s argument and the %s %s instead.", d.ReplacementAttribute, d.ReplacementResource, replacementKind)
+	case d.ReplacementResource != "":
+		fmt.Fprintf(&b, "Use the %s %s instead.", d.ReplacementResource, replacementKind)
+	case d.ReplacementAttribute != "" && strings.Contains(d.Attribute, ".") && !strings.Contains(d.ReplacementAttribute, "."):
+		fmt.Fprintf(&b, "Use the top-level %s argument instead.", d.ReplacementAttribute)
+	case d.ReplacementAttribute != "":
+		fmt.Fprintf(&b, "Use %s instead.", d.ReplacementAttribute)
+	}
+
+	if b.Len() > 0 {
+		b.WriteString(" ")
+	}
+
+	fmt.Fprintf(&b, "%s will be removed in version %s of the AWS provider.", d.Attribute, d.RemovalVersion)
+
+	if d.ImportID != "" {
+		fmt.Fprintf(&b, " To migrate, add an %s resource and import it: %s", d.ReplacementResource, d.ImportCommand("<name>", nil))
+	}
+
+	return b.String()
+}
+
+// ImportCommand returns the command which imports ReplacementResource with the specified name,
+// e.g. terraform import aws_s3_bucket_acl.example example-bucket,private.
+// ImportID's placeholders are replaced by the deprecated resource's argument values, or <argument> if there is none.
+// Returns "" if ReplacementResource cannot be imported in place of the argument.
+func (d Deprecation) ImportCommand(name string, values map[string]string) string {
+	if d.ImportID == "" {
+		return ""
+	}
+
+	id := importIDPlaceholderRegexp.ReplaceAllStringFunc(d.ImportID, func(placeholder string) string {
+		argument := strings.Trim(placeholder, "{}")
+
+		if v, ok := values[argument]; ok && v != "" {
+			return v
+		}
+
+		return "<" + argument + ">"
+	})
+
+	return fmt.Sprintf("terraform import %s.%s %s", d.ReplacementResource, name, id)
+}
+
+func (d Deprecation) replacementKind() Kind {
+	if d.Kind == KindDataSource {
+		return KindDataSource
+	}
+
+	return KindResource
+}
+
+var importIDPlaceholderRegexp = regexp.MustCompile(`{[a-z0-9_]+}`)
+
+type key struct {
+	kind      Kind
+	typeName  string
+	attribute string
+}
+
+var registry = func() map[key]Deprecation {
+	m := make(map[key]Deprecation, len(deprecations))
+
+	for _, d := range deprecations {
+		k := key{kind: d.Kind, typeName: d.TypeName, attribute: d.Attribute}
+
+		if _, ok := m[k]; ok {
+			panic(fmt.Sprintf("duplicate deprecation: %s %s %s", d.Kind, d.TypeName, d.Attribute))
+		}
+
+		m[k] = d
+	}
+
+	return m
+}()
+
+// All returns every registered deprecation.
+func All() []Deprecation {
+	return append([]Deprecation(nil), deprecations...)
+}
+
+// Lookup returns the registered deprecation of a provider, resource or data source argument.
+func Lookup(kind Kind, typeName, attribute string) (Deprecation, bool) {
+	d, ok := registry[key{kind: kind, typeName: typeName, attribute: attribute}]
+
+	return d, ok
+}
+
+// ProviderMessage returns the Deprecated message of a provider argument.
+// It panics if the argument's deprecation is not registered.
+func ProviderMessage(attribute string) string {
+	return mustLookup(KindProvider, "", attribute).Message()
+}
+
+// ResourceMessage returns the Deprecated message of a resource argument.
+// It panics if the argument's deprecation is not registered.
+func ResourceMessage(typeName, attribute string) string {
+	return mustLookup(KindResource, typeName, attribute).Message()
+}
+
+// DataSourceMessage returns the Deprecated message of a data source argument.
+// It panics if the argument's deprecation is not registered.
+func DataSourceMessage(typeName, attribute string) string {
+	return mustLookup(KindDataSource, typeName, attribute).Message()
+}
+
+func mustLookup(kind Kind, typeName, attribute string) Deprecation {
+	d, ok := Lookup(kind, typeName, attribute)
+
+	if !ok {
+		panic(fmt.Sprintf("deprecation not registered: %s %s %s", kind, typeName, attribute))
+	}
+
+	return d
+}
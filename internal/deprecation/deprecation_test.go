@@ -0,0 +1,138 @@
+package deprecation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
+)
+
+func TestDeprecationMessage(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Deprecation deprecation.Deprecation
+		Expected    string
+	}{
+		{
+			Name: "replacement attribute",
+			Deprecation: deprecation.Deprecation{
+				Kind:                 deprecation.KindProvider,
+				Attribute:            "shared_credentials_file",
+				RemovalVersion:       "5.0.0",
+				ReplacementAttribute: "shared_credentials_files",
+			},
+			Expected: "Use shared_credentials_files instead. shared_credentials_file will be removed in version 5.0.0 of the AWS provider.",
+		},
+		{
+			Name: "top-level replacement attribute",
+			Deprecation: deprecation.Deprecation{
+				Kind:                 deprecation.KindResource,
+				TypeName:             "aws_s3_bucket",
+				Attribute:            "object_lock_configuration.object_lock_enabled",
+				RemovalVersion:       "5.0.0",
+				ReplacementAttribute: "object_lock_enabled",
+			},
+			Expected: "Use the top-level object_lock_enabled argument instead. object_lock_configuration.object_lock_enabled will be removed in version 5.0.0 of the AWS provider.",
+		},
+		{
+			Name: "replacement resource",
+			Deprecation: deprecation.Deprecation{
+				Kind:                deprecation.KindResource,
+				TypeName:            "aws_s3_bucket",
+				Attribute:           "acl",
+				RemovalVersion:      "5.0.0",
+				ReplacementResource: "aws_s3_bucket_acl",
+				ImportID:            "{bucket},{acl}",
+			},
+			Expected: "Use the aws_s3_bucket_acl resource instead. acl will be removed in version 5.0.0 of the AWS provider. " +
+				"To migrate, add an aws_s3_bucket_acl resource and import it: terraform import aws_s3_bucket_acl.<name> <bucket>,<acl>",
+		},
+		{
+			Name: "replacement resource attribute",
+			Deprecation: deprecation.Deprecation{
+				Kind:                         deprecation.KindResource,
+				TypeName:                     "aws_s3_bucket",
+				Attribute:                    "website_endpoint",
+				RemovalVersion:               "5.0.0",
+				ReplacementResource:          "aws_s3_bucket_website_configuration",
+				ReplacementResourceAttribute: "website_endpoint",
+			},
+			Expected: "Use the website_endpoint attribute of the aws_s3_bucket_website_configuration resource instead. website_endpoint will be removed in version 5.0.0 of the AWS provider.",
+		},
+		{
+			Name: "replacement data source",
+			Deprecation: deprecation.Deprecation{
+				Kind:                deprecation.KindDataSource,
+				TypeName:            "aws_secretsmanager_secret",
+				Attribute:           "rotation_enabled",
+				RemovalVersion:      "5.0.0",
+				ReplacementResource: "aws_secretsmanager_secret_rotation",
+			},
+			Expected: "Use the aws_secretsmanager_secret_rotation data source instead. rotation_enabled will be removed in version 5.0.0 of the AWS provider.",
+		},
+		{
+			Name: "no replacement",
+			Deprecation: deprecation.Deprecation{
+				Kind:           deprecation.KindResource,
+				TypeName:       "aws_example_thing",
+				Attribute:      "broken",
+				RemovalVersion: "5.0.0",
+			},
+			Expected: "broken will be removed in version 5.0.0 of the AWS provider.",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Deprecation.Message(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDeprecationImportCommand(t *testing.T) {
+	d, ok := deprecation.Lookup(deprecation.KindResource, "aws_s3_bucket", "acl")
+
+	if !ok {
+		t.Fatal("deprecation not registered")
+	}
+
+	if got, expected := d.ImportCommand("example", map[string]string{"bucket": "example-bucket", "acl": "private"}), "terraform import aws_s3_bucket_acl.example example-bucket,private"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.ImportCommand("example", map[string]string{"bucket": "example-bucket"}), "terraform import aws_s3_bucket_acl.example example-bucket,<acl>"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	d, ok = deprecation.Lookup(deprecation.KindResource, "aws_s3_bucket", "website_endpoint")
+
+	if !ok {
+		t.Fatal("deprecation not registered")
+	}
+
+	if got := d.ImportCommand("example", nil); got != "" {
+		t.Errorf("got %q, expected no import command", got)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if _, ok := deprecation.Lookup(deprecation.KindProvider, "", "shared_credentials_file"); !ok {
+		t.Error("expected provider argument shared_credentials_file to be deprecated")
+	}
+
+	if _, ok := deprecation.Lookup(deprecation.KindResource, "aws_s3_bucket", "bucket"); ok {
+		t.Error("expected resource aws_s3_bucket argument bucket not to be deprecated")
+	}
+}
+
+func TestResourceMessage_notRegistered(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	deprecation.ResourceMessage("aws_s3_bucket", "bucket")
+}
@@ -0,0 +1,166 @@
+package deprecation
+
+// nextMajorVersion is the next major version of the provider.
+const nextMajorVersion = "5.0.0"
+
+// deprecations is the registry of deprecated arguments, sorted by kind, type name and argument.
+// Reference a deprecation's Message from the argument's schema, e.g.
+//
+//	Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "acl"),
+var deprecations = []Deprecation{
+	{
+		Kind:                 KindProvider,
+		Attribute:            "assume_role.duration_seconds",
+		RemovalVersion:       nextMajorVersion,
+		ReplacementAttribute: "assume_role.duration",
+	},
+	{
+		Kind:                 KindProvider,
+		Attribute:            "s3_force_path_style",
+		RemovalVersion:       nextMajorVersion,
+		ReplacementAttribute: "s3_use_path_style",
+	},
+	{
+		Kind:                 KindProvider,
+		Attribute:            "shared_credentials_file",
+		RemovalVersion:       nextMajorVersion,
+		ReplacementAttribute: "shared_credentials_files",
+	},
+
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "acceleration_status",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_accelerate_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "acl",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_acl",
+		ImportID:            "{bucket},{acl}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "cors_rule",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_cors_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "grant",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_acl",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "lifecycle_rule",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_lifecycle_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "logging",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_logging",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                 KindResource,
+		TypeName:             "aws_s3_bucket",
+		Attribute:            "object_lock_configuration",
+		RemovalVersion:       nextMajorVersion,
+		ReplacementAttribute: "object_lock_enabled",
+		ReplacementResource:  "aws_s3_bucket_object_lock_configuration",
+		ImportID:             "{bucket}",
+	},
+	{
+		Kind:                 KindResource,
+		TypeName:             "aws_s3_bucket",
+		Attribute:            "object_lock_configuration.object_lock_enabled",
+		RemovalVersion:       nextMajorVersion,
+		ReplacementAttribute: "object_lock_enabled",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "object_lock_configuration.rule",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_object_lock_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "policy",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_policy",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "replication_configuration",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_replication_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "request_payer",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_request_payment_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "server_side_encryption_configuration",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_server_side_encryption_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "versioning",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_versioning",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                KindResource,
+		TypeName:            "aws_s3_bucket",
+		Attribute:           "website",
+		RemovalVersion:      nextMajorVersion,
+		ReplacementResource: "aws_s3_bucket_website_configuration",
+		ImportID:            "{bucket}",
+	},
+	{
+		Kind:                         KindResource,
+		TypeName:                     "aws_s3_bucket",
+		Attribute:                    "website_domain",
+		RemovalVersion:               nextMajorVersion,
+		ReplacementResource:          "aws_s3_bucket_website_configuration",
+		ReplacementResourceAttribute: "website_domain",
+	},
+	{
+		Kind:                         KindResource,
+		TypeName:                     "aws_s3_bucket",
+		Attribute:                    "website_endpoint",
+		RemovalVersion:               nextMajorVersion,
+		ReplacementResource:          "aws_s3_bucket_website_configuration",
+		ReplacementResourceAttribute: "website_endpoint",
+	},
+}
@@ -0,0 +1,188 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
+)
+
+const (
+	keybasePrefix = "keybase:"
+
+	// KeyFingerprintAttribute is the name of the attribute holding the fingerprint of the PGP key used for encryption.
+	KeyFingerprintAttribute = "key_fingerprint"
+	// PGPKeyAttribute is the name of a resource's own PGP key argument.
+	PGPKeyAttribute = "pgp_key"
+	// UseProviderPGPKeyAttribute is the name of a resource's argument opting in to, or out of, the provider-level PGP key.
+	UseProviderPGPKeyAttribute = "use_provider_pgp_key"
+)
+
+// PGPKeySchema returns the schema for a resource's optional PGP key.
+// Secrets are encrypted when the resource is created so changing the key forces a new resource.
+func PGPKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	}
+}
+
+// DataSourcePGPKeySchema returns the schema for a data source's optional PGP key.
+func DataSourcePGPKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+// UseProviderPGPKeySchema returns the schema for a resource's use of the provider-level PGP key.
+// Unset, the provider-level PGP key is used if one is configured. Set to false, it is never used.
+func UseProviderPGPKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		ForceNew: true,
+	}
+}
+
+// DataSourceUseProviderPGPKeySchema returns the schema for a data source's use of the provider-level PGP key.
+// Unset, the provider-level PGP key is used if one is configured. Set to false, it is never used.
+func DataSourceUseProviderPGPKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+// EncryptedValueSchema returns the schema for an encrypted secret attribute.
+func EncryptedValueSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// KeyFingerprintSchema returns the schema for the fingerprint of the PGP key used to encrypt secret attributes.
+func KeyFingerprintSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// EncryptedAttributeName returns the name of the attribute holding the encrypted value of the named secret attribute.
+func EncryptedAttributeName(name string) string {
+	return "encrypted_" + name
+}
+
+// RetrievePGPKey returns the PGP key specified as the pgpKey parameter, or queries
+// the public key from the keybase service if the parameter is a keybase username
+// prefixed with the phrase "keybase:"
+func RetrievePGPKey(pgpKey string) (string, error) {
+	encryptionKey := pgpKey
+	if strings.HasPrefix(pgpKey, keybasePrefix) {
+		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{pgpKey})
+		if err != nil {
+			return "", fmt.Errorf("retrieving Public Key for %s: %w", pgpKey, err)
+		}
+		encryptionKey = publicKeys[pgpKey]
+	}
+
+	return encryptionKey, nil
+}
+
+// ResolvePGPKey returns the encryption key for a resource: its pgp_key argument if set, otherwise
+// the provider-level PGP key unless the resource opts out with use_provider_pgp_key = false. An empty string is
+// returned if encryption isn't requested, in which case secrets are written to state in plaintext.
+func ResolvePGPKey(d *schema.ResourceData, providerPGPKey string) (string, error) {
+	var resourcePGPKey string
+
+	if v, ok := d.GetOk(PGPKeyAttribute); ok {
+		resourcePGPKey = v.(string)
+	}
+
+	// use_provider_pgp_key = false must be told apart from the argument not being set.
+	var useProviderPGPKey *bool
+
+	if rawConfig := d.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr(UseProviderPGPKeyAttribute); v.IsKnown() && !v.IsNull() {
+			b := v.True()
+			useProviderPGPKey = &b
+		}
+	}
+
+	return resolvePGPKey(resourcePGPKey, useProviderPGPKey, providerPGPKey)
+}
+
+func resolvePGPKey(resourcePGPKey string, useProviderPGPKey *bool, providerPGPKey string) (string, error) {
+	pgpKey := resourcePGPKey
+
+	if pgpKey == "" {
+		switch {
+		case useProviderPGPKey == nil:
+			pgpKey = providerPGPKey
+		case *useProviderPGPKey:
+			if providerPGPKey == "" {
+				return "", fmt.Errorf("%s is true but no provider-level pgp_key is configured", UseProviderPGPKeyAttribute)
+			}
+
+			pgpKey = providerPGPKey
+		}
+	}
+
+	if pgpKey == "" {
+		return "", nil
+	}
+
+	return RetrievePGPKey(pgpKey)
+}
+
+// EncryptValue encrypts the given value with the given encryption key. Description
+// should be set such that errors return a meaningful user-facing response.
+func EncryptValue(encryptionKey, value, description string) (string, string, error) {
+	fingerprints, encryptedValue, err :=
+		pgpkeys.EncryptShares([][]byte{[]byte(value)}, []string{encryptionKey})
+	if err != nil {
+		return "", "", fmt.Errorf("encrypting %s: %w", description, err)
+	}
+
+	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
+}
+
+// SetSecrets writes secret values to state. If encryptionKey is set, each non-empty value is
+// encrypted into its encrypted_<name> attribute, the key's fingerprint is set and the plaintext
+// attribute is cleared. Otherwise each value is written in plaintext to its own attribute.
+func SetSecrets(d *schema.ResourceData, encryptionKey string, secrets map[string]string) error {
+	if encryptionKey == "" {
+		for name, value := range secrets {
+			if err := d.Set(name, value); err != nil {
+				return fmt.Errorf("setting %s: %w", name, err)
+			}
+		}
+
+		return nil
+	}
+
+	for name, value := range secrets {
+		d.Set(name, "")
+
+		if value == "" {
+			d.Set(EncryptedAttributeName(name), "")
+			continue
+		}
+
+		fingerprint, encrypted, err := EncryptValue(encryptionKey, value, name)
+
+		if err != nil {
+			return err
+		}
+
+		d.Set(KeyFingerprintAttribute, fingerprint)
+		d.Set(EncryptedAttributeName(name), encrypted)
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
+)
+
+// testKeyring holds a locally generated PGP key pair, base64-encoded as the provider expects.
+type testKeyring struct {
+	publicKey   string
+	privateKey  string
+	fingerprint string
+}
+
+func newTestKeyring(t *testing.T) testKeyring {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("Terraform Test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("generating PGP key: %s", err)
+	}
+
+	var public, private bytes.Buffer
+
+	if err := entity.Serialize(&public); err != nil {
+		t.Fatalf("serializing public key: %s", err)
+	}
+
+	if err := entity.SerializePrivate(&private, nil); err != nil {
+		t.Fatalf("serializing private key: %s", err)
+	}
+
+	publicKey := base64.StdEncoding.EncodeToString(public.Bytes())
+	fingerprints, err := pgpkeys.GetFingerprints([]string{publicKey}, nil)
+	if err != nil {
+		t.Fatalf("getting fingerprint: %s", err)
+	}
+
+	return testKeyring{
+		publicKey:   publicKey,
+		privateKey:  base64.StdEncoding.EncodeToString(private.Bytes()),
+		fingerprint: fingerprints[0],
+	}
+}
+
+func (k testKeyring) decrypt(t *testing.T, encrypted string) string {
+	t.Helper()
+
+	plaintext, err := pgpkeys.DecryptBytes(encrypted, k.privateKey)
+	if err != nil {
+		t.Fatalf("decrypting: %s", err)
+	}
+
+	return plaintext.String()
+}
+
+func testResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"encrypted_password": EncryptedValueSchema(),
+		"encrypted_token":    EncryptedValueSchema(),
+		"key_fingerprint":    KeyFingerprintSchema(),
+		"password": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"pgp_key": PGPKeySchema(),
+		"token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"use_provider_pgp_key": UseProviderPGPKeySchema(),
+	}, raw)
+}
+
+func TestRetrievePGPKey(t *testing.T) {
+	keyring := newTestKeyring(t)
+
+	for _, pgpKey := range []string{"", keyring.publicKey} {
+		got, err := RetrievePGPKey(pgpKey)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != pgpKey {
+			t.Errorf("got %q, expected %q", got, pgpKey)
+		}
+	}
+}
+
+func TestResolvePGPKey(t *testing.T) {
+	resourceKeyring := newTestKeyring(t)
+	providerKeyring := newTestKeyring(t)
+	optIn, optOut := true, false
+
+	testCases := []struct {
+		TestName          string
+		ResourcePGPKey    string
+		UseProviderPGPKey *bool
+		ProviderPGPKey    string
+		Expected          string
+		ExpectedError     string
+	}{
+		{
+			TestName: "none",
+		},
+		{
+			TestName:       "provider by default",
+			ProviderPGPKey: providerKeyring.publicKey,
+			Expected:       providerKeyring.publicKey,
+		},
+		{
+			TestName:          "provider opted out",
+			UseProviderPGPKey: &optOut,
+			ProviderPGPKey:    providerKeyring.publicKey,
+		},
+		{
+			TestName:          "provider opted in",
+			UseProviderPGPKey: &optIn,
+			ProviderPGPKey:    providerKeyring.publicKey,
+			Expected:          providerKeyring.publicKey,
+		},
+		{
+			TestName:          "opted in without provider key",
+			UseProviderPGPKey: &optIn,
+			ExpectedError:     "no provider-level pgp_key is configured",
+		},
+		{
+			TestName:       "resource",
+			ResourcePGPKey: resourceKeyring.publicKey,
+			Expected:       resourceKeyring.publicKey,
+		},
+		{
+			TestName:       "resource overrides provider",
+			ResourcePGPKey: resourceKeyring.publicKey,
+			ProviderPGPKey: providerKeyring.publicKey,
+			Expected:       resourceKeyring.publicKey,
+		},
+		{
+			TestName:          "resource with provider opted out",
+			ResourcePGPKey:    resourceKeyring.publicKey,
+			UseProviderPGPKey: &optOut,
+			ProviderPGPKey:    providerKeyring.publicKey,
+			Expected:          resourceKeyring.publicKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := resolvePGPKey(testCase.ResourcePGPKey, testCase.UseProviderPGPKey, testCase.ProviderPGPKey)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResolvePGPKeyResourceData(t *testing.T) {
+	resourceKeyring := newTestKeyring(t)
+	providerKeyring := newTestKeyring(t)
+
+	got, err := ResolvePGPKey(testResourceData(t, map[string]interface{}{}), providerKeyring.publicKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != providerKeyring.publicKey {
+		t.Errorf("got %q, expected the provider-level key", got)
+	}
+
+	got, err = ResolvePGPKey(testResourceData(t, map[string]interface{}{
+		"pgp_key": resourceKeyring.publicKey,
+	}), providerKeyring.publicKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != resourceKeyring.publicKey {
+		t.Errorf("got %q, expected the resource's key", got)
+	}
+}
+
+func TestEncryptValue(t *testing.T) {
+	keyring := newTestKeyring(t)
+
+	fingerprint, encrypted, err := EncryptValue(keyring.publicKey, "s3cr3t", "test secret")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fingerprint != keyring.fingerprint {
+		t.Errorf("got fingerprint %q, expected %q", fingerprint, keyring.fingerprint)
+	}
+
+	if got, expected := keyring.decrypt(t, encrypted), "s3cr3t"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func TestEncryptValue_invalidKey(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		EncryptionKey string
+		ExpectedError string
+	}{
+		{
+			TestName:      "not base64",
+			EncryptionKey: "not base64!",
+			ExpectedError: "error decoding given PGP key",
+		},
+		{
+			TestName:      "not a key",
+			EncryptionKey: base64.StdEncoding.EncodeToString([]byte("not a key")),
+			ExpectedError: "error parsing given PGP key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, _, err := EncryptValue(testCase.EncryptionKey, "s3cr3t", "test secret")
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !strings.Contains(err.Error(), "encrypting test secret") || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestSetSecrets_plaintext(t *testing.T) {
+	d := testResourceData(t, map[string]interface{}{})
+
+	if err := SetSecrets(d, "", map[string]string{"password": "s3cr3t", "token": "t0k3n"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, expected := range map[string]string{
+		"encrypted_password": "",
+		"encrypted_token":    "",
+		"key_fingerprint":    "",
+		"password":           "s3cr3t",
+		"token":              "t0k3n",
+	} {
+		if got := d.Get(name).(string); got != expected {
+			t.Errorf("%s: got %q, expected %q", name, got, expected)
+		}
+	}
+}
+
+func TestSetSecrets_encrypted(t *testing.T) {
+	keyring := newTestKeyring(t)
+	d := testResourceData(t, map[string]interface{}{})
+
+	if err := SetSecrets(d, keyring.publicKey, map[string]string{"password": "s3cr3t", "token": ""}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for name, expected := range map[string]string{
+		"encrypted_token": "",
+		"key_fingerprint": keyring.fingerprint,
+		"password":        "",
+		"token":           "",
+	} {
+		if got := d.Get(name).(string); got != expected {
+			t.Errorf("%s: got %q, expected %q", name, got, expected)
+		}
+	}
+
+	if got, expected := keyring.decrypt(t, d.Get("encrypted_password").(string)), "s3cr3t"; got != expected {
+		t.Errorf("decrypted password: got %q, expected %q", got, expected)
+	}
+}
+
+// TestSetSecrets_reencrypted documents that PGP encryption isn't deterministic: each call produces a new
+// ciphertext, e.g. on each read of a data source, while the key fingerprint and decrypted value are unchanged.
+func TestSetSecrets_reencrypted(t *testing.T) {
+	keyring := newTestKeyring(t)
+	d1 := testResourceData(t, map[string]interface{}{})
+	d2 := testResourceData(t, map[string]interface{}{})
+
+	for _, d := range []*schema.ResourceData{d1, d2} {
+		if err := SetSecrets(d, keyring.publicKey, map[string]string{"password": "s3cr3t"}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if d1.Get("encrypted_password").(string) == d2.Get("encrypted_password").(string) {
+		t.Error("expected a new ciphertext on each call")
+	}
+
+	if got, expected := d2.Get("key_fingerprint").(string), d1.Get("key_fingerprint").(string); got != expected {
+		t.Errorf("key_fingerprint: got %q, expected %q", got, expected)
+	}
+
+	for _, d := range []*schema.ResourceData{d1, d2} {
+		if got, expected := keyring.decrypt(t, d.Get("encrypted_password").(string)), "s3cr3t"; got != expected {
+			t.Errorf("decrypted password: got %q, expected %q", got, expected)
+		}
+	}
+}
@@ -72,6 +72,67 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweeper behavior
+const (
+	// If set to a true value, sweepers list candidate resources without deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated list of resource name (or ID) prefixes that sweepers must keep
+	SweepKeepPrefixes = "TF_AWS_SWEEP_KEEP_PREFIXES"
+
+	// Tag key, or key=value pair, marking resources that sweepers must keep
+	SweepKeepTag = "TF_AWS_SWEEP_KEEP_TAG"
+
+	// Minimum age (Go duration, e.g. 24h) of resources that sweepers may delete.
+	// Younger resources are kept.
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Path of a file to which sweepers append a JSON Lines report of each resource's outcome
+	SweepReportFile = "TF_AWS_SWEEP_REPORT_FILE"
+
+	// Number of times failed sweepers, and failed deletions within a dependency wave, are retried
+	// before the sweepers that depend on them are skipped
+	SweepRetries = "TF_AWS_SWEEP_RETRIES"
+
+	// Comma-separated list of resource type=limit pairs, e.g. aws_instance=5,aws_vpc=2, limiting the number of
+	// concurrent deletions, and of concurrently running sweepers, in the dependency wave containing each type.
+	// Sweepers in waves without a limit run one at a time
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+)
+
+// Custom environment variables used to control acceptance test concurrency pools
+const (
+	// Maximum time (Go duration, e.g. 30m) a test waits in a concurrency pool's queue before failing.
+	// Defaults to 1 hour.
+	AccPoolWaitTimeout = "TF_ACC_POOL_WAIT_TIMEOUT"
+
+	// Concurrency limit for acceptance tests creating EC2 Client VPN endpoints
+	EC2ClientVPNLimit = "AWS_EC2_CLIENT_VPN_LIMIT"
+
+	// Concurrency limit for acceptance tests creating EC2 Elastic IPs
+	EC2EIPLimit = "AWS_EC2_EIP_LIMIT"
+
+	// Concurrency limit for acceptance tests creating VPCs
+	EC2VPCLimit = "AWS_EC2_VPC_LIMIT"
+
+	// Concurrency limit for acceptance tests creating Organizations accounts
+	OrganizationsAccountLimit = "AWS_ORGANIZATIONS_ACCOUNT_LIMIT"
+)
+
+// Custom environment variables used to run the provider and acceptance tests without access to AWS
+const (
+	// Base URL of a local stand-in for AWS, such as LocalStack, used for every service endpoint not explicitly configured.
+	// Account and region validation are skipped and a synthetic account ID is used.
+	LocalEndpoint = "TF_AWS_LOCAL_ENDPOINT"
+
+	// Path of the JSON Lines file of HTTP interactions recorded, or replayed, by acceptance tests
+	RecordFile = "TF_AWS_RECORD_FILE"
+
+	// Acceptance test HTTP traffic mode: "record" captures AWS API traffic to TF_AWS_RECORD_FILE,
+	// "replay" serves AWS API traffic from TF_AWS_RECORD_FILE without network access
+	RecordMode = "TF_AWS_RECORD_MODE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -13,6 +13,24 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with the formatted summary.
+// If any of the arguments is an error with a remediation hint (see Classify), the hint is the diagnostic's detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...)
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+		Detail:   hintFromArgs(a),
+	})
+}
+
+func hintFromArgs(a []any) string {
+	for _, v := range a {
+		if err, ok := v.(error); ok {
+			if hint := Hint(err); hint != "" {
+				return hint
+			}
+		}
+	}
+
+	return ""
 }
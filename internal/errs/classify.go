@@ -0,0 +1,296 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+)
+
+// Category is a broad classification of an AWS API error.
+type Category int
+
+const (
+	CategoryUnknown Category = iota
+	CategoryThrottling
+	CategoryAccessDenied
+	CategoryQuotaExceeded
+	CategoryConflict
+	CategoryNotFound
+	CategoryValidation
+	// CategoryInsufficientCapacity indicates that AWS does not currently have enough capacity to fulfill the request.
+	// Unlike CategoryQuotaExceeded, it is not caused by the account's quotas.
+	CategoryInsufficientCapacity
+)
+
+func (c Category) String() string {
+	switch c {
+	case CategoryThrottling:
+		return "throttling"
+	case CategoryAccessDenied:
+		return "access denied"
+	case CategoryQuotaExceeded:
+		return "quota exceeded"
+	case CategoryConflict:
+		return "conflict"
+	case CategoryNotFound:
+		return "not found"
+	case CategoryValidation:
+		return "validation"
+	case CategoryInsufficientCapacity:
+		return "insufficient capacity"
+	default:
+		return "unknown"
+	}
+}
+
+// Classification is the result of classifying an error.
+type Classification struct {
+	Category Category
+	// Code is the AWS error code, if any.
+	Code string
+	// Message is the AWS error message, if any.
+	Message string
+	// Hint is a user-facing remediation hint. Empty for CategoryUnknown.
+	Hint string
+}
+
+// categoryCodes maps well-known AWS error codes to categories.
+var categoryCodes = map[string]Category{
+	"BandwidthLimitExceeded":                 CategoryThrottling,
+	"EC2ThrottledException":                  CategoryThrottling,
+	"PriorRequestNotComplete":                CategoryThrottling,
+	"ProvisionedThroughputExceededException": CategoryThrottling,
+	"RequestLimitExceeded":                   CategoryThrottling,
+	"RequestThrottled":                       CategoryThrottling,
+	"RequestThrottledException":              CategoryThrottling,
+	"SlowDown":                               CategoryThrottling,
+	"ThrottledException":                     CategoryThrottling,
+	"Throttling":                             CategoryThrottling,
+	"ThrottlingException":                    CategoryThrottling,
+	"TooManyRequestsException":               CategoryThrottling,
+
+	"AccessDenied":                CategoryAccessDenied,
+	"AccessDeniedException":       CategoryAccessDenied,
+	"AuthFailure":                 CategoryAccessDenied,
+	"AuthorizationError":          CategoryAccessDenied,
+	"AuthorizationErrorException": CategoryAccessDenied,
+	"ExpiredToken":                CategoryAccessDenied,
+	"ExpiredTokenException":       CategoryAccessDenied,
+	"Forbidden":                   CategoryAccessDenied,
+	"ForbiddenException":          CategoryAccessDenied,
+	"InvalidClientTokenId":        CategoryAccessDenied,
+	"NotAuthorized":               CategoryAccessDenied,
+	"OptInRequired":               CategoryAccessDenied,
+	"SignatureDoesNotMatch":       CategoryAccessDenied,
+	"UnauthorizedException":       CategoryAccessDenied,
+	"UnauthorizedOperation":       CategoryAccessDenied,
+	"UnrecognizedClientException": CategoryAccessDenied,
+
+	"LimitExceeded":                 CategoryQuotaExceeded,
+	"LimitExceededException":        CategoryQuotaExceeded,
+	"QuotaExceededException":        CategoryQuotaExceeded,
+	"ServiceQuotaExceededException": CategoryQuotaExceeded,
+	"TooManyBuckets":                CategoryQuotaExceeded,
+	"TooManyTagsException":          CategoryQuotaExceeded,
+	"MaxSpotInstanceCountExceeded":  CategoryQuotaExceeded,
+	"VcpuLimitExceeded":             CategoryQuotaExceeded,
+	"InstanceLimitExceeded":         CategoryQuotaExceeded,
+	"AddressLimitExceeded":          CategoryQuotaExceeded,
+
+	"InsufficientCapacity":                 CategoryInsufficientCapacity,
+	"InsufficientCapacityException":        CategoryInsufficientCapacity,
+	"InsufficientDBInstanceCapacity":       CategoryInsufficientCapacity,
+	"InsufficientHostCapacity":             CategoryInsufficientCapacity,
+	"InsufficientInstanceCapacity":         CategoryInsufficientCapacity,
+	"InsufficientReservedInstanceCapacity": CategoryInsufficientCapacity,
+
+	"EntityAlreadyExistsException":    CategoryConflict,
+	"EntityAlreadyExists":             CategoryConflict,
+	"ConcurrentModification":          CategoryConflict,
+	"ConcurrentModificationException": CategoryConflict,
+	"ConflictException":               CategoryConflict,
+	"DeleteConflict":                  CategoryConflict,
+	"DependencyViolation":             CategoryConflict,
+	"IncorrectState":                  CategoryConflict,
+	"InvalidStateException":           CategoryConflict,
+	"OperationAbortedException":       CategoryConflict,
+	"OperationAborted":                CategoryConflict,
+	"ResourceAlreadyExistsException":  CategoryConflict,
+	"ResourceConflictException":       CategoryConflict,
+	"ResourceInUse":                   CategoryConflict,
+	"ResourceInUseException":          CategoryConflict,
+
+	"NoSuchEntity":              CategoryNotFound,
+	"NotFoundException":         CategoryNotFound,
+	"ResourceNotFound":          CategoryNotFound,
+	"ResourceNotFoundException": CategoryNotFound,
+
+	"InvalidArgument":                  CategoryValidation,
+	"InvalidInput":                     CategoryValidation,
+	"InvalidInputException":            CategoryValidation,
+	"InvalidParameter":                 CategoryValidation,
+	"InvalidParameterCombination":      CategoryValidation,
+	"InvalidParameterException":        CategoryValidation,
+	"InvalidParameterValue":            CategoryValidation,
+	"InvalidParameterValueException":   CategoryValidation,
+	"InvalidRequest":                   CategoryValidation,
+	"InvalidRequestException":          CategoryValidation,
+	"MalformedPolicyDocument":          CategoryValidation,
+	"MalformedPolicyDocumentException": CategoryValidation,
+	"MissingParameter":                 CategoryValidation,
+	"ValidationError":                  CategoryValidation,
+	"ValidationException":              CategoryValidation,
+}
+
+// categoryCodePatterns classifies error codes not listed in categoryCodes, in order.
+var categoryCodePatterns = []struct {
+	category Category
+	match    func(code string) bool
+}{
+	{CategoryThrottling, func(code string) bool { return strings.Contains(code, "Throttl") }},
+	{CategoryInsufficientCapacity, func(code string) bool {
+		return strings.HasPrefix(code, "Insufficient") && strings.Contains(code, "Capacity")
+	}},
+	{CategoryQuotaExceeded, func(code string) bool {
+		return strings.HasSuffix(code, "LimitExceeded") || strings.HasSuffix(code, "LimitExceededException") || strings.HasSuffix(code, "QuotaExceeded")
+	}},
+	{CategoryConflict, func(code string) bool {
+		return strings.HasSuffix(code, "InUse") || strings.HasSuffix(code, "InUseException") || strings.HasSuffix(code, "AlreadyExists") || strings.HasSuffix(code, "Conflict")
+	}},
+	{CategoryNotFound, func(code string) bool {
+		return strings.HasPrefix(code, "NoSuch") || strings.HasSuffix(code, "NotFound") || strings.HasSuffix(code, "NotFoundException") || strings.HasSuffix(code, "NotFoundFault")
+	}},
+	{CategoryValidation, func(code string) bool {
+		return strings.HasPrefix(code, "Invalid") || strings.HasPrefix(code, "Malformed")
+	}},
+}
+
+// categoryStatusCodes classifies errors by HTTP status code when the error code is not recognized.
+var categoryStatusCodes = map[int]Category{
+	403: CategoryAccessDenied,
+	404: CategoryNotFound,
+	409: CategoryConflict,
+	429: CategoryThrottling,
+}
+
+var (
+	deniedActionRegexp    = regexp.MustCompile(`not authorized to perform:? ([a-zA-Z0-9-]+:[a-zA-Z0-9*]+)`)
+	deniedPrincipalRegexp = regexp.MustCompile(`User: (arn:\S+) is not authorized`)
+)
+
+// Classify unwraps the error and classifies it by its AWS SDK for Go v1 or v2 error code,
+// falling back to its HTTP status code.
+func Classify(err error) Classification {
+	code, message, statusCode := apiErrorDetails(err)
+
+	category, ok := categoryCodes[code]
+	if !ok && code != "" {
+		for _, v := range categoryCodePatterns {
+			if v.match(code) {
+				category, ok = v.category, true
+				break
+			}
+		}
+	}
+	if !ok {
+		category = categoryStatusCodes[statusCode]
+	}
+
+	return Classification{
+		Category: category,
+		Code:     code,
+		Message:  message,
+		Hint:     remediationHint(category, message),
+	}
+}
+
+// IsCategory returns true if the error is classified as any of the specified categories.
+func IsCategory(err error, categories ...Category) bool {
+	if err == nil {
+		return false
+	}
+
+	category := Classify(err).Category
+
+	for _, v := range categories {
+		if category == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hint returns the remediation hint for the error, or an empty string.
+func Hint(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return Classify(err).Hint
+}
+
+func apiErrorDetails(err error) (string, string, int) {
+	var (
+		code, message string
+		statusCode    int
+	)
+
+	var awsErr awserr.Error
+	var apiErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		code, message = awsErr.Code(), awsErr.Message()
+	} else if errors.As(err, &apiErr) {
+		code, message = apiErr.ErrorCode(), apiErr.ErrorMessage()
+	}
+
+	// AWS SDK for Go v1.
+	var requestFailure awserr.RequestFailure
+	// AWS SDK for Go v2.
+	var responseError interface{ HTTPStatusCode() int }
+	if errors.As(err, &requestFailure) {
+		statusCode = requestFailure.StatusCode()
+	} else if errors.As(err, &responseError) {
+		statusCode = responseError.HTTPStatusCode()
+	}
+
+	return code, message, statusCode
+}
+
+func remediationHint(category Category, message string) string {
+	switch category {
+	case CategoryThrottling:
+		return "AWS throttled the request. Retry the operation later, reduce Terraform's parallelism (-parallelism), or request a higher API rate quota from AWS."
+	case CategoryAccessDenied:
+		return accessDeniedHint(message)
+	case CategoryQuotaExceeded:
+		return "An AWS service quota or limit was reached. Remove unused resources or request a quota increase using Service Quotas."
+	case CategoryConflict:
+		return "The resource already exists, is in use, or is being modified by another operation. Wait for in-progress operations to complete or remove dependent resources, then retry."
+	case CategoryNotFound:
+		return "The resource was not found. It may have been deleted outside of Terraform, or may not be visible yet due to eventual consistency."
+	case CategoryValidation:
+		return "AWS rejected the request as invalid. Check the configured argument values against the AWS API documentation."
+	case CategoryInsufficientCapacity:
+		return "AWS does not currently have enough capacity for the request. Retry later, or use another Availability Zone or instance type."
+	default:
+		return ""
+	}
+}
+
+func accessDeniedHint(message string) string {
+	principal := "the principal Terraform is using"
+	if m := deniedPrincipalRegexp.FindStringSubmatch(message); m != nil {
+		principal = m[1]
+	}
+
+	if m := deniedActionRegexp.FindStringSubmatch(message); m != nil {
+		return fmt.Sprintf("Grant the IAM action %s to %s, and check that no service control policy, permissions boundary or resource policy denies it.", m[1], principal)
+	}
+
+	return fmt.Sprintf("Check that %s is allowed to perform this operation, and that no service control policy, permissions boundary or resource policy denies it.", principal)
+}
@@ -0,0 +1,222 @@
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+type httpStatusError struct {
+	error
+	statusCode int
+}
+
+func (e httpStatusError) HTTPStatusCode() int {
+	return e.statusCode
+}
+
+func (e httpStatusError) Unwrap() error {
+	return e.error
+}
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected errs.Category
+	}{
+		{
+			Name:     "nil error",
+			Expected: errs.CategoryUnknown,
+		},
+		{
+			Name:     "other error",
+			Err:      errors.New("ThrottlingException"),
+			Expected: errs.CategoryUnknown,
+		},
+		{
+			Name:     "v1 throttling",
+			Err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected: errs.CategoryThrottling,
+		},
+		{
+			Name:     "v1 wrapped access denied",
+			Err:      fmt.Errorf("reading: %w", awserr.New("AccessDeniedException", "denied", nil)),
+			Expected: errs.CategoryAccessDenied,
+		},
+		{
+			Name:     "v1 quota exceeded pattern",
+			Err:      awserr.New("VpcLimitExceeded", "The maximum number of VPCs has been reached.", nil),
+			Expected: errs.CategoryQuotaExceeded,
+		},
+		{
+			Name:     "v1 request limit exceeded is throttling",
+			Err:      awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			Expected: errs.CategoryThrottling,
+		},
+		{
+			Name:     "v1 conflict pattern",
+			Err:      awserr.New("InvalidGroup.InUse", "Group is in use", nil),
+			Expected: errs.CategoryConflict,
+		},
+		{
+			Name:     "v1 not found pattern",
+			Err:      awserr.New("InvalidVpcID.NotFound", "The vpc ID does not exist", nil),
+			Expected: errs.CategoryNotFound,
+		},
+		{
+			Name:     "v1 no such",
+			Err:      awserr.New("NoSuchBucket", "The specified bucket does not exist", nil),
+			Expected: errs.CategoryNotFound,
+		},
+		{
+			Name:     "v1 validation pattern",
+			Err:      awserr.New("InvalidAMIID.Malformed", "Invalid id", nil),
+			Expected: errs.CategoryValidation,
+		},
+		{
+			Name:     "v1 status code",
+			Err:      awserr.NewRequestFailure(awserr.New("Unrecognized", "", nil), 404, "id"),
+			Expected: errs.CategoryNotFound,
+		},
+		{
+			Name:     "v2 API error",
+			Err:      &smithy.GenericAPIError{Code: "ServiceQuotaExceededException", Message: "quota"},
+			Expected: errs.CategoryQuotaExceeded,
+		},
+		{
+			Name:     "v1 insufficient capacity",
+			Err:      awserr.New("InsufficientInstanceCapacity", "We currently do not have sufficient capacity in the Availability Zone you requested.", nil),
+			Expected: errs.CategoryInsufficientCapacity,
+		},
+		{
+			Name:     "v1 insufficient capacity pattern",
+			Err:      awserr.New("InsufficientDBInstanceCapacityFault", "Cannot create a database instance because there is insufficient capacity.", nil),
+			Expected: errs.CategoryInsufficientCapacity,
+		},
+		{
+			Name:     "v2 insufficient capacity",
+			Err:      &smithy.GenericAPIError{Code: "InsufficientCapacityException", Message: "capacity"},
+			Expected: errs.CategoryInsufficientCapacity,
+		},
+		{
+			Name:     "v2 wrapped API error",
+			Err:      fmt.Errorf("creating: %w", &smithy.GenericAPIError{Code: "ConflictException", Message: "conflict"}),
+			Expected: errs.CategoryConflict,
+		},
+		{
+			Name:     "v2 status code",
+			Err:      httpStatusError{error: &smithy.GenericAPIError{Code: "Unrecognized"}, statusCode: 429},
+			Expected: errs.CategoryThrottling,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := errs.Classify(testCase.Err)
+
+			if got.Category != testCase.Expected {
+				t.Errorf("got %s, expected %s", got.Category, testCase.Expected)
+			}
+
+			if (got.Hint == "") != (testCase.Expected == errs.CategoryUnknown) {
+				t.Errorf("unexpected hint: %q", got.Hint)
+			}
+		})
+	}
+}
+
+func TestHint_accessDenied(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Contains []string
+	}{
+		{
+			Name:     "action and principal",
+			Err:      awserr.New("AccessDenied", "User: arn:aws:iam::123456789012:user/test is not authorized to perform: s3:GetObject on resource: arn:aws:s3:::test", nil),
+			Contains: []string{"s3:GetObject", "arn:aws:iam::123456789012:user/test"},
+		},
+		{
+			Name:     "action",
+			Err:      &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "You are not authorized to perform: kms:Decrypt"},
+			Contains: []string{"kms:Decrypt", "the principal Terraform is using"},
+		},
+		{
+			Name:     "no details",
+			Err:      awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil),
+			Contains: []string{"the principal Terraform is using"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			hint := errs.Hint(testCase.Err)
+
+			for _, v := range testCase.Contains {
+				if !strings.Contains(hint, v) {
+					t.Errorf("hint %q does not contain %q", hint, v)
+				}
+			}
+		})
+	}
+}
+
+func TestHint_insufficientCapacity(t *testing.T) {
+	hint := errs.Hint(awserr.New("InsufficientInstanceCapacity", "We currently do not have sufficient capacity in the Availability Zone you requested.", nil))
+
+	if strings.Contains(hint, "quota") {
+		t.Errorf("hint %q suggests a quota increase for a capacity shortage", hint)
+	}
+
+	if !strings.Contains(hint, "Availability Zone") {
+		t.Errorf("hint %q does not suggest another Availability Zone", hint)
+	}
+}
+
+func TestIsCategory(t *testing.T) {
+	err := awserr.New("ResourceInUseException", "in use", nil)
+
+	if !errs.IsCategory(err, errs.CategoryThrottling, errs.CategoryConflict) {
+		t.Error("expected conflict error to match")
+	}
+
+	if errs.IsCategory(err, errs.CategoryNotFound) {
+		t.Error("expected conflict error not to match not found")
+	}
+
+	if errs.IsCategory(nil, errs.CategoryUnknown) {
+		t.Error("expected nil error not to match")
+	}
+}
+
+func TestAppendErrorf(t *testing.T) {
+	var diags diag.Diagnostics
+
+	diags = errs.AppendErrorf(diags, "creating Thing (%s): %s", "test", awserr.New("ThrottlingException", "Rate exceeded", nil))
+	diags = errs.AppendErrorf(diags, "creating Thing (%s): %s", "test", errors.New("other"))
+
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, expected 2", len(diags))
+	}
+
+	if got, expected := diags[0].Summary, "creating Thing (test): ThrottlingException: Rate exceeded"; got != expected {
+		t.Errorf("got summary %q, expected %q", got, expected)
+	}
+
+	if got, expected := diags[0].Detail, errs.Hint(awserr.New("ThrottlingException", "", nil)); got != expected {
+		t.Errorf("got detail %q, expected %q", got, expected)
+	}
+
+	if got := diags[1].Detail; got != "" {
+		t.Errorf("got detail %q, expected none", got)
+	}
+}
@@ -50,9 +50,20 @@ func NewErrorDiagnostic(summary, detail string) diag.Diagnostic {
 	}
 }
 
+// NewErrorDiagnosticFromErr returns an error diagnostic with the error message as detail,
+// followed by the error's remediation hint, if any (see Classify).
+func NewErrorDiagnosticFromErr(summary string, err error) diag.Diagnostic {
+	detail := err.Error()
+	if hint := Hint(err); hint != "" {
+		detail = fmt.Sprintf("%s\n\n%s", detail, hint)
+	}
+
+	return NewErrorDiagnostic(summary, detail)
+}
+
 func FromAttributeError(path cty.Path, err error) diag.Diagnostic {
 	return withPath(
-		NewErrorDiagnostic(err.Error(), ""),
+		NewErrorDiagnostic(err.Error(), Hint(err)),
 		path,
 	)
 }
@@ -0,0 +1,293 @@
+package fwmigrate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
+)
+
+// FindFrameworkResource returns the service package's Plugin Framework resource with the specified type name.
+func FindFrameworkResource(ctx context.Context, spd intf.ServicePackageData, typeName string) (resource.Resource, error) {
+	for _, factory := range spd.FrameworkResources(ctx) {
+		v, err := factory(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		response := resource.MetadataResponse{}
+		v.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+		if response.TypeName == typeName {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Plugin Framework resource (%s) not found in service package %s", typeName, spd.ServicePackageName())
+}
+
+// FindFrameworkDataSource returns the service package's Plugin Framework data source with the specified type name.
+func FindFrameworkDataSource(ctx context.Context, spd intf.ServicePackageData, typeName string) (datasource.DataSource, error) {
+	for _, factory := range spd.FrameworkDataSources(ctx) {
+		v, err := factory(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		response := datasource.MetadataResponse{}
+		v.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+		if response.TypeName == typeName {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Plugin Framework data source (%s) not found in service package %s", typeName, spd.ServicePackageName())
+}
+
+// CheckResourceSchemaEquivalence returns an error describing every difference between the schema of a Plugin SDK v2 resource
+// and the schema of the Plugin Framework resource that replaces it.
+// Attribute and block paths, attribute types, block nesting modes and the required/optional/computed flags are compared.
+// Plugin SDK attributes with a Default value are expected to be Computed in the Plugin Framework schema.
+func CheckResourceSchemaEquivalence(ctx context.Context, sdkResource *schema.Resource, fwResource resource.Resource) error {
+	sdkSchema, err := sdkResourceSchema(ctx, sdkResource, false)
+
+	if err != nil {
+		return err
+	}
+
+	fwSchema, diags := fwResource.GetSchema(ctx)
+
+	if diags.HasError() {
+		return errs.NewDiagnosticsError(diags)
+	}
+
+	return checkSchemaEquivalence(ctx, sdkResource, sdkSchema, fwSchema)
+}
+
+// CheckDataSourceSchemaEquivalence returns an error describing every difference between the schema of a Plugin SDK v2 data source
+// and the schema of the Plugin Framework data source that replaces it.
+func CheckDataSourceSchemaEquivalence(ctx context.Context, sdkDataSource *schema.Resource, fwDataSource datasource.DataSource) error {
+	sdkSchema, err := sdkResourceSchema(ctx, sdkDataSource, true)
+
+	if err != nil {
+		return err
+	}
+
+	fwSchema, diags := fwDataSource.GetSchema(ctx)
+
+	if diags.HasError() {
+		return errs.NewDiagnosticsError(diags)
+	}
+
+	return checkSchemaEquivalence(ctx, sdkDataSource, sdkSchema, fwSchema)
+}
+
+// schemaElement is the comparable part of an attribute or block.
+type schemaElement struct {
+	isBlock  bool
+	nesting  string
+	typ      tftypes.Type
+	required bool
+	optional bool
+	computed bool
+}
+
+func checkSchemaEquivalence(ctx context.Context, sdkResource *schema.Resource, sdkSchema *tfprotov5.Schema, fwSchema tfsdk.Schema) error {
+	sdkElements := make(map[string]schemaElement)
+	walkSDKBlock(nil, sdkSchema.Block, sdkResource.Schema, sdkElements)
+
+	fwElements := make(map[string]schemaElement)
+	walkFrameworkSchema(ctx, nil, fwSchema.Attributes, fwSchema.Blocks, fwElements)
+
+	paths := make([]string, 0, len(sdkElements)+len(fwElements))
+	for path := range sdkElements {
+		paths = append(paths, path)
+	}
+	for path := range fwElements {
+		if _, ok := sdkElements[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var result *multierror.Error
+
+	for _, path := range paths {
+		sdk, inSDK := sdkElements[path]
+		fw, inFramework := fwElements[path]
+
+		switch {
+		case !inFramework:
+			result = multierror.Append(result, fmt.Errorf("%s: not found in Plugin Framework schema", path))
+			continue
+		case !inSDK:
+			result = multierror.Append(result, fmt.Errorf("%s: not found in Plugin SDK schema", path))
+			continue
+		}
+
+		if sdk.isBlock != fw.isBlock {
+			result = multierror.Append(result, fmt.Errorf("%s: block %t, want %t", path, fw.isBlock, sdk.isBlock))
+			continue
+		}
+
+		if sdk.isBlock {
+			if sdk.nesting != fw.nesting {
+				result = multierror.Append(result, fmt.Errorf("%s: nesting mode %s, want %s", path, fw.nesting, sdk.nesting))
+			}
+
+			continue
+		}
+
+		if !sdk.typ.Equal(fw.typ) {
+			result = multierror.Append(result, fmt.Errorf("%s: type %s, want %s", path, fw.typ, sdk.typ))
+		}
+
+		if sdk.required != fw.required {
+			result = multierror.Append(result, fmt.Errorf("%s: required %t, want %t", path, fw.required, sdk.required))
+		}
+
+		if sdk.optional != fw.optional {
+			result = multierror.Append(result, fmt.Errorf("%s: optional %t, want %t", path, fw.optional, sdk.optional))
+		}
+
+		if sdk.computed != fw.computed {
+			result = multierror.Append(result, fmt.Errorf("%s: computed %t, want %t", path, fw.computed, sdk.computed))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// sdkResourceSchema returns the schema of a Plugin SDK v2 resource or data source as sent to Terraform.
+// This includes any attributes and blocks implicitly added by the Plugin SDK, such as "id" and "timeouts".
+func sdkResourceSchema(ctx context.Context, r *schema.Resource, isDataSource bool) (*tfprotov5.Schema, error) {
+	const typeName = "sdk"
+
+	p := &schema.Provider{}
+
+	if isDataSource {
+		p.DataSourcesMap = map[string]*schema.Resource{typeName: r}
+	} else {
+		p.ResourcesMap = map[string]*schema.Resource{typeName: r}
+	}
+
+	response, err := schema.NewGRPCProviderServer(p).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("reading Plugin SDK schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	if isDataSource {
+		return response.DataSourceSchemas[typeName], nil
+	}
+
+	return response.ResourceSchemas[typeName], nil
+}
+
+func walkSDKBlock(path []string, block *tfprotov5.SchemaBlock, properties map[string]*schema.Schema, elements map[string]schemaElement) {
+	for _, v := range block.Attributes {
+		element := schemaElement{
+			typ:      v.Type,
+			required: v.Required,
+			optional: v.Optional,
+			computed: v.Computed,
+		}
+
+		// Plugin Framework attributes with a default value must be Computed.
+		if property, ok := properties[v.Name]; ok && property.Default != nil {
+			element.computed = true
+		}
+
+		elements[pathString(path, v.Name)] = element
+	}
+
+	for _, v := range block.BlockTypes {
+		elements[pathString(path, v.TypeName)] = schemaElement{
+			isBlock: true,
+			nesting: sdkNestingMode(v.Nesting),
+		}
+
+		var nestedProperties map[string]*schema.Schema
+
+		if property, ok := properties[v.TypeName]; ok {
+			if v, ok := property.Elem.(*schema.Resource); ok {
+				nestedProperties = v.Schema
+			}
+		}
+
+		walkSDKBlock(append(path, v.TypeName), v.Block, nestedProperties, elements)
+	}
+}
+
+func walkFrameworkSchema(ctx context.Context, path []string, attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block, elements map[string]schemaElement) {
+	for name, v := range attributes {
+		elements[pathString(path, name)] = schemaElement{
+			typ:      v.FrameworkType().TerraformType(ctx),
+			required: v.Required,
+			optional: v.Optional,
+			computed: v.Computed,
+		}
+	}
+
+	for name, v := range blocks {
+		elements[pathString(path, name)] = schemaElement{
+			isBlock: true,
+			nesting: frameworkNestingMode(v),
+		}
+
+		walkFrameworkSchema(ctx, append(path, name), v.Attributes, v.Blocks, elements)
+	}
+}
+
+func pathString(path []string, name string) string {
+	return strings.Join(append(path[:len(path):len(path)], name), ".")
+}
+
+func sdkNestingMode(mode tfprotov5.SchemaNestedBlockNestingMode) string {
+	switch mode {
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return "single"
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return "list"
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return "set"
+	case tfprotov5.SchemaNestedBlockNestingModeMap:
+		return "map"
+	case tfprotov5.SchemaNestedBlockNestingModeGroup:
+		return "group"
+	default:
+		return "invalid"
+	}
+}
+
+func frameworkNestingMode(block tfsdk.Block) string {
+	switch block.NestingMode {
+	case tfsdk.BlockNestingModeSingle:
+		return "single"
+	case tfsdk.BlockNestingModeList:
+		return "list"
+	case tfsdk.BlockNestingModeSet:
+		return "set"
+	default:
+		return "invalid"
+	}
+}
@@ -0,0 +1,220 @@
+package fwmigrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testResource struct {
+	schema tfsdk.Schema
+}
+
+func (r *testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testResource) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return r.schema, nil
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+func testSDKResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func testFrameworkSchema() tfsdk.Schema {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"enabled": {
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"rule": {
+				Attributes: map[string]tfsdk.Attribute{
+					"priority": {
+						Type:     types.Int64Type,
+						Optional: true,
+						Computed: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+				MaxItems:    1,
+			},
+			"timeouts": {
+				Attributes: map[string]tfsdk.Attribute{
+					"create": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeSingle,
+			},
+		},
+	}
+}
+
+func TestCheckResourceSchemaEquivalence(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		modify     func(*tfsdk.Schema)
+		wantErrors []string
+	}{
+		{
+			name:   "equivalent",
+			modify: func(*tfsdk.Schema) {},
+		},
+		{
+			name: "missing attribute",
+			modify: func(s *tfsdk.Schema) {
+				delete(s.Attributes, "tags")
+			},
+			wantErrors: []string{"tags: not found in Plugin Framework schema"},
+		},
+		{
+			name: "extra attribute",
+			modify: func(s *tfsdk.Schema) {
+				s.Attributes["arn"] = tfsdk.Attribute{Type: types.StringType, Computed: true}
+			},
+			wantErrors: []string{"arn: not found in Plugin SDK schema"},
+		},
+		{
+			name: "type differs",
+			modify: func(s *tfsdk.Schema) {
+				s.Blocks["rule"].Attributes["priority"] = tfsdk.Attribute{Type: types.StringType, Optional: true, Computed: true}
+			},
+			wantErrors: []string{"rule.priority: type tftypes.String, want tftypes.Number"},
+		},
+		{
+			name: "flags differ",
+			modify: func(s *tfsdk.Schema) {
+				s.Attributes["enabled"] = tfsdk.Attribute{Type: types.BoolType, Optional: true}
+				s.Attributes["name"] = tfsdk.Attribute{Type: types.StringType, Optional: true, Computed: true}
+			},
+			wantErrors: []string{
+				"enabled: computed false, want true",
+				"name: required false, want true",
+				"name: optional true, want false",
+				"name: computed true, want false",
+			},
+		},
+		{
+			name: "nesting mode differs",
+			modify: func(s *tfsdk.Schema) {
+				block := s.Blocks["rule"]
+				block.NestingMode = tfsdk.BlockNestingModeSet
+				s.Blocks["rule"] = block
+			},
+			wantErrors: []string{"rule: nesting mode set, want list"},
+		},
+		{
+			name: "block instead of attribute",
+			modify: func(s *tfsdk.Schema) {
+				delete(s.Blocks, "rule")
+				s.Attributes["rule"] = tfsdk.Attribute{Type: types.ListType{ElemType: types.StringType}, Optional: true}
+			},
+			wantErrors: []string{
+				"rule: block false, want true",
+				"rule.priority: not found in Plugin Framework schema",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fwSchema := testFrameworkSchema()
+			testCase.modify(&fwSchema)
+
+			err := CheckResourceSchemaEquivalence(context.Background(), testSDKResource(), &testResource{schema: fwSchema})
+
+			if len(testCase.wantErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			for _, want := range testCase.wantErrors {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
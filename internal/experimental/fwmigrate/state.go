@@ -0,0 +1,63 @@
+package fwmigrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// UpgradeSDKState upgrades a resource's prior state by running the specified Plugin SDK v2 state upgrade functions in order.
+// It is intended to be called from a Plugin Framework resource's StateUpgrader for prior state written by the Plugin SDK.
+// The upgrade functions must, between them, upgrade the prior state to the current schema version.
+func UpgradeSDKState(ctx context.Context, meta interface{}, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse, upgraders ...schema.StateUpgradeFunc) {
+	if request.RawState == nil || request.RawState.JSON == nil {
+		response.Diagnostics.AddError("Unable to Upgrade Resource State", "Prior state is not in JSON format.")
+
+		return
+	}
+
+	var rawState map[string]interface{}
+
+	if err := json.Unmarshal(request.RawState.JSON, &rawState); err != nil {
+		response.Diagnostics.AddError("Unable to Upgrade Resource State", "Unmarshaling prior state: "+err.Error())
+
+		return
+	}
+
+	for _, upgrader := range upgraders {
+		var err error
+
+		rawState, err = upgrader(ctx, rawState, meta)
+
+		if err != nil {
+			response.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+
+			return
+		}
+	}
+
+	upgradedState, err := json.Marshal(rawState)
+
+	if err != nil {
+		response.Diagnostics.AddError("Unable to Upgrade Resource State", "Marshaling upgraded state: "+err.Error())
+
+		return
+	}
+
+	response.DynamicValue = &tfprotov6.DynamicValue{
+		JSON: upgradedState,
+	}
+}
+
+// UpgradeNotImplemented returns a Plugin SDK v2 state upgrade function that always fails.
+// It stands in for the named state upgrade function until that function is ported, so that
+// prior state is never silently passed through unchanged.
+func UpgradeNotImplemented(name string) schema.StateUpgradeFunc {
+	return func(context.Context, map[string]interface{}, interface{}) (map[string]interface{}, error) {
+		return nil, fmt.Errorf("upgrade not implemented: state upgrade function %s has not been ported", name)
+	}
+}
@@ -0,0 +1,102 @@
+package fwmigrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestUpgradeSDKState(t *testing.T) {
+	t.Parallel()
+
+	renameV0 := func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		rawState["name"] = rawState["title"]
+		delete(rawState, "title")
+
+		return rawState, nil
+	}
+	defaultV1 := func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		if _, ok := rawState["enabled"]; !ok {
+			rawState["enabled"] = true
+		}
+
+		return rawState, nil
+	}
+	failing := func(context.Context, map[string]interface{}, interface{}) (map[string]interface{}, error) {
+		return nil, errors.New("test error")
+	}
+
+	ctx := context.Background()
+	request := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"abc","title":"test"}`)},
+	}
+
+	t.Run("upgraded", func(t *testing.T) {
+		t.Parallel()
+
+		response := resource.UpgradeStateResponse{}
+		UpgradeSDKState(ctx, nil, request, &response, renameV0, defaultV1)
+
+		if response.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", response.Diagnostics)
+		}
+
+		if response.DynamicValue == nil {
+			t.Fatal("expected DynamicValue")
+		}
+
+		var got map[string]interface{}
+
+		if err := json.Unmarshal(response.DynamicValue.JSON, &got); err != nil {
+			t.Fatal(err)
+		}
+
+		want := map[string]interface{}{"id": "abc", "name": "test", "enabled": true}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("upgrader error", func(t *testing.T) {
+		t.Parallel()
+
+		response := resource.UpgradeStateResponse{}
+		UpgradeSDKState(ctx, nil, request, &response, renameV0, failing)
+
+		if !response.Diagnostics.HasError() {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("upgrade not implemented", func(t *testing.T) {
+		t.Parallel()
+
+		response := resource.UpgradeStateResponse{}
+		UpgradeSDKState(ctx, nil, request, &response, renameV0, UpgradeNotImplemented("testStateUpgradeV1"))
+
+		if !response.Diagnostics.HasError() {
+			t.Fatal("expected error")
+		}
+
+		if response.DynamicValue != nil {
+			t.Error("unexpected DynamicValue")
+		}
+	})
+
+	t.Run("flatmap state", func(t *testing.T) {
+		t.Parallel()
+
+		response := resource.UpgradeStateResponse{}
+		UpgradeSDKState(ctx, nil, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{Flatmap: map[string]string{"id": "abc"}}}, &response, renameV0)
+
+		if !response.Diagnostics.HasError() {
+			t.Fatal("expected error")
+		}
+	})
+}
@@ -0,0 +1,429 @@
+// Package httprecorder records HTTP interactions to a fixture file and replays them deterministically,
+// allowing acceptance tests to exercise resource CRUD logic without network access.
+package httprecorder
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode string
+
+const (
+	// ModeRecord sends requests to the wrapped transport and records each interaction.
+	ModeRecord Mode = "record"
+	// ModeReplay serves responses from recorded interactions without sending requests.
+	ModeReplay Mode = "replay"
+)
+
+// Interaction is a single recorded HTTP request and its response.
+// A fixture file contains one JSON-encoded Interaction per line.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded HTTP request.
+// Only request headers used to match interactions are recorded, so the Authorization header is never recorded.
+// Secret values in the URL and in request and response bodies, such as temporary credentials, access key secrets
+// and secret values, are replaced with Redacted before the interaction is recorded. See secretFieldNames.
+type Request struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    Body              `json:"body"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       Body                `json:"body"`
+}
+
+// Body is a recorded HTTP message body.
+// Valid UTF-8 bodies are stored as-is, others are base64-encoded.
+type Body struct {
+	Data     string `json:"data,omitempty"`
+	Encoding string `json:"encoding,omitempty"` // "" or "base64".
+}
+
+const (
+	bodyEncodingBase64 = "base64"
+)
+
+var (
+	// Request headers recorded, and used to match interactions.
+	recordedRequestHeaders = []string{"Content-Type", "X-Amz-Target"}
+
+	// Response headers never recorded.
+	omittedResponseHeaders = []string{"Set-Cookie"}
+)
+
+// ErrNoInteraction is returned when replaying a request for which no unused interaction was recorded.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Recorder records, or replays, HTTP interactions.
+type Recorder struct {
+	mode Mode
+	path string
+
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+	queues  map[string][]*Interaction
+}
+
+// New returns a new Recorder.
+// In record mode the fixture file at path is created, or truncated, and each interaction is appended to it.
+// In replay mode all interactions are read from the fixture file at path.
+func New(mode Mode, path string) (*Recorder, error) {
+	r := &Recorder{
+		mode: mode,
+		path: path,
+	}
+
+	switch mode {
+	case ModeRecord:
+		file, err := os.Create(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("creating HTTP recorder fixture file (%s): %w", path, err)
+		}
+
+		r.file = file
+		r.encoder = json.NewEncoder(file)
+
+	case ModeReplay:
+		queues, err := readInteractions(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		r.queues = queues
+
+	default:
+		return nil, fmt.Errorf("unsupported HTTP recorder mode: %q", mode)
+	}
+
+	return r, nil
+}
+
+// Mode returns the Recorder's mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Wrap returns an http.RoundTripper that records interactions sent to next, or, in replay mode, replays them.
+// In replay mode next is never used.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if r.mode == ModeReplay {
+			return r.replay(req)
+		}
+
+		return r.record(next, req)
+	})
+}
+
+// Close closes the fixture file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	err := r.file.Close()
+	r.file = nil
+	r.encoder = nil
+
+	return err
+}
+
+// Remaining returns the number of recorded interactions not yet replayed.
+func (r *Recorder) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := 0
+	for _, queue := range r.queues {
+		n += len(queue)
+	}
+
+	return n
+}
+
+func (r *Recorder) record(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+
+	if err != nil {
+		// Transport errors are not recorded.
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     redactURL(req.URL),
+			Headers: requestHeaders(req.Header),
+			Body:    newBody(redactBody(req.Header.Get("Content-Type"), reqBody)),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    responseHeaders(resp.Header),
+			Body:       newBody(redactBody(resp.Header.Get("Content-Type"), respBody)),
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.encoder == nil {
+		return nil, fmt.Errorf("recording HTTP interaction: recorder closed")
+	}
+
+	if err := r.encoder.Encode(interaction); err != nil {
+		return nil, fmt.Errorf("recording HTTP interaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := matchKey(req.Method, req.URL, req.Header.Get("Content-Type"), req.Header.Get("X-Amz-Target"), reqBody)
+
+	r.mu.Lock()
+	queue := r.queues[key]
+	var interaction *Interaction
+	if len(queue) > 0 {
+		interaction = queue[0]
+		r.queues[key] = queue[1:]
+	}
+	r.mu.Unlock()
+
+	if interaction == nil {
+		return nil, fmt.Errorf("replaying %s %s: %w (%s)", req.Method, req.URL, ErrNoInteraction, r.path)
+	}
+
+	body, err := interaction.Response.Body.bytes()
+
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header, len(interaction.Response.Headers))
+	for k, v := range interaction.Response.Headers {
+		header[http.CanonicalHeaderKey(k)] = v
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func readInteractions(path string) (map[string][]*Interaction, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening HTTP recorder fixture file (%s): %w", path, err)
+	}
+
+	defer file.Close()
+
+	queues := make(map[string][]*Interaction)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		interaction := &Interaction{}
+
+		if err := json.Unmarshal(scanner.Bytes(), interaction); err != nil {
+			return nil, fmt.Errorf("reading HTTP recorder fixture file (%s) line %d: %w", path, line, err)
+		}
+
+		u, err := url.Parse(interaction.Request.URL)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading HTTP recorder fixture file (%s) line %d: %w", path, line, err)
+		}
+
+		body, err := interaction.Request.Body.bytes()
+
+		if err != nil {
+			return nil, fmt.Errorf("reading HTTP recorder fixture file (%s) line %d: %w", path, line, err)
+		}
+
+		headers := http.Header{}
+		for k, v := range interaction.Request.Headers {
+			headers.Set(k, v)
+		}
+
+		key := matchKey(interaction.Request.Method, u, headers.Get("Content-Type"), headers.Get("X-Amz-Target"), body)
+		queues[key] = append(queues[key], interaction)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading HTTP recorder fixture file (%s): %w", path, err)
+	}
+
+	return queues, nil
+}
+
+// matchKey returns the key used to match a request to recorded interactions.
+// Requests match on method, host, path, query parameters (in any order) and JSON protocol operation (X-Amz-Target).
+// Secret query parameters are redacted when recording so they are ignored.
+// For Query protocol requests, which all POST to the same path, the Action and Version form parameters are also used.
+// Interactions with the same key are replayed in the order recorded.
+func matchKey(method string, u *url.URL, contentType, target string, body []byte) string {
+	query := u.Query()
+
+	for k := range query {
+		if isSecretField(k) {
+			query.Del(k)
+		}
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for _, k := range []string{"Action", "Version"} {
+				if v := form.Get(k); v != "" {
+					query.Set(k, v)
+				}
+			}
+		}
+	}
+
+	// url.Values.Encode sorts by key.
+	// Values for the same key are also sorted so that parameter order never matters.
+	for k := range query {
+		sort.Strings(query[k])
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	return strings.Join([]string{method, u.Host, path, query.Encode(), target}, " ")
+}
+
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	(*body).Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("reading HTTP body: %w", err)
+	}
+
+	*body = io.NopCloser(bytes.NewReader(b))
+
+	return b, nil
+}
+
+func newBody(b []byte) Body {
+	if utf8.Valid(b) {
+		return Body{Data: string(b)}
+	}
+
+	return Body{Data: base64.StdEncoding.EncodeToString(b), Encoding: bodyEncodingBase64}
+}
+
+func (b Body) bytes() ([]byte, error) {
+	switch b.Encoding {
+	case "":
+		return []byte(b.Data), nil
+	case bodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(b.Data)
+	default:
+		return nil, fmt.Errorf("unsupported HTTP body encoding: %q", b.Encoding)
+	}
+}
+
+func requestHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string)
+
+	for _, k := range recordedRequestHeaders {
+		if v := header.Get(k); v != "" {
+			headers[k] = v
+		}
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return headers
+}
+
+func responseHeaders(header http.Header) map[string][]string {
+	headers := header.Clone()
+
+	for _, k := range omittedResponseHeaders {
+		headers.Del(k)
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+
+	return headers
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,273 @@
+package httprecorder
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("X-Amzn-Requestid", "abc")
+		w.Header().Set("Set-Cookie", "secret")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case r.Header.Get("X-Amz-Target") != "":
+			io.WriteString(w, r.Header.Get("X-Amz-Target")+" "+string(body))
+		default:
+			form, _ := url.ParseQuery(string(body))
+			io.WriteString(w, form.Get("Action")+" "+r.URL.RawQuery)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixture.jsonl")
+
+	type request struct {
+		contentType, target, query, body string
+	}
+	requests := []request{
+		{"application/x-amz-json-1.1", "Service.CreateThing", "", `{"n":1}`},
+		{"application/x-amz-json-1.1", "Service.CreateThing", "", `{"n":2}`},
+		{"application/x-www-form-urlencoded; charset=utf-8", "", "", "Action=DescribeThings&Version=2016-11-15"},
+		{"", "", "b=2&a=1", ""},
+	}
+
+	do := func(t *testing.T, client *http.Client, r request) string {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/?"+r.query, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIA")
+		if r.contentType != "" {
+			req.Header.Set("Content-Type", r.contentType)
+		}
+		if r.target != "" {
+			req.Header.Set("X-Amz-Target", r.target)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(body)
+	}
+
+	// Record.
+	recorder, err := New(ModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: recorder.Wrap(http.DefaultTransport)}
+	var recorded []string
+	for _, r := range requests {
+		recorded = append(recorded, do(t, client, r))
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != len(requests) {
+		t.Fatalf("server calls: got %d, want %d", calls, len(requests))
+	}
+
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(fixture), "AKIA") {
+		t.Error("fixture contains credentials")
+	}
+
+	if strings.Contains(string(fixture), "secret") {
+		t.Error("fixture contains omitted response header")
+	}
+
+	// Replay, with the JSON requests in the order recorded and the query parameters reordered.
+	recorder, err = New(ModeReplay, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := recorder.Remaining(), len(requests); got != want {
+		t.Errorf("remaining: got %d, want %d", got, want)
+	}
+
+	client = &http.Client{Transport: recorder.Wrap(nil)}
+	replayOrder := []int{2, 0, 3, 1}
+	for _, i := range replayOrder {
+		r := requests[i]
+		if i == 3 {
+			r.query = "a=1&b=2"
+		}
+
+		if got, want := do(t, client, r), recorded[i]; got != want {
+			t.Errorf("request %d: got %q, want %q", i, got, want)
+		}
+	}
+
+	if calls != len(requests) {
+		t.Errorf("server called during replay")
+	}
+
+	if got, want := recorder.Remaining(), 0; got != want {
+		t.Errorf("remaining: got %d, want %d", got, want)
+	}
+
+	// All interactions have been used.
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/", strings.NewReader(requests[0].body))
+	req.Header.Set("X-Amz-Target", requests[0].target)
+	_, err = recorder.Wrap(nil).RoundTrip(req)
+
+	if !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("expected ErrNoInteraction, got %v", err)
+	}
+}
+
+func TestBody(t *testing.T) {
+	for _, b := range [][]byte{nil, []byte("text"), {0xff, 0xfe, 0x00}} {
+		body := newBody(b)
+
+		got, err := body.bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != string(b) {
+			t.Errorf("got %q, want %q", got, b)
+		}
+	}
+
+	if got, want := newBody([]byte{0xff}).Encoding, bodyEncodingBase64; got != want {
+		t.Errorf("encoding: got %q, want %q", got, want)
+	}
+}
+
+func TestNewUnsupportedMode(t *testing.T) {
+	if _, err := New("bogus", ""); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRedact(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		ContentType string
+		Body        string
+		Expected    string
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName: "JSON",
+			Body:     `{"SecretString":"s3cr3t","Name":"test","Versions":[{"secretBinary":"czNjcjN0"}],"Count":1}`,
+			Expected: `{"Count":1,"Name":"test","SecretString":"REDACTED","Versions":[{"secretBinary":"REDACTED"}]}`,
+		},
+		{
+			TestName: "JSON without secrets",
+			Body:     `{"Name": "test"}`,
+			Expected: `{"Name": "test"}`,
+		},
+		{
+			TestName: "XML",
+			Body:     `<Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>s3cr3t</SecretAccessKey><SessionToken>t0k3n</SessionToken></Credentials>`,
+			Expected: `<Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>`,
+		},
+		{
+			TestName:    "form",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Body:        "Action=CreateLoginProfile&Password=s3cr3t&UserName=test",
+			Expected:    "Action=CreateLoginProfile&Password=REDACTED&UserName=test",
+		},
+		{
+			TestName: "other",
+			Body:     "Password=s3cr3t",
+			Expected: "Password=s3cr3t",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := string(redactBody(testCase.ContentType, []byte(testCase.Body))); got != testCase.Expected {
+				t.Errorf("got %q, want %q", got, testCase.Expected)
+			}
+		})
+	}
+
+	u, _ := url.Parse("https://example.com/key?X-Amz-Security-Token=t0k3n&versionId=1")
+	if got, want := redactURL(u), "https://example.com/key?X-Amz-Security-Token=REDACTED&versionId=1"; got != want {
+		t.Errorf("URL: got %q, want %q", got, want)
+	}
+
+	// Redacted query parameters are ignored when matching.
+	v, _ := url.Parse("https://example.com/key?X-Amz-Security-Token=REDACTED&versionId=1")
+	if got, want := matchKey(http.MethodGet, u, "", "", nil), matchKey(http.MethodGet, v, "", "", nil); got != want {
+		t.Errorf("match key: got %q, want %q", got, want)
+	}
+
+	// Recorded interactions are redacted.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		io.WriteString(w, `{"AccessKey":{"AccessKeyId":"AKIA","SecretAccessKey":"s3cr3t"}}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "fixture.jsonl")
+	recorder, err := New(ModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/", strings.NewReader(`{"Password":"p4ssw0rd"}`))
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	resp, err := recorder.Wrap(http.DefaultTransport).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if !strings.Contains(string(body), "s3cr3t") {
+		t.Errorf("response body redacted before returning: %s", body)
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fixture, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"s3cr3t", "p4ssw0rd"} {
+		if strings.Contains(string(fixture), secret) {
+			t.Errorf("fixture contains %q", secret)
+		}
+	}
+}
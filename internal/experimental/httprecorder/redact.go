@@ -0,0 +1,163 @@
+package httprecorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secret values in recorded interactions.
+// It is also valid base64, so redacted binary values such as SecretBinary still decode when replayed.
+const Redacted = "REDACTED"
+
+// Names of JSON fields, XML elements and form and query parameters holding secret values.
+// Names are compared case-insensitively, and only the last component of dotted form parameter names is used.
+var secretFieldNames = []string{
+	"AuthToken",
+	"MasterUserPassword",
+	"NewPassword",
+	"OldPassword",
+	"Password",
+	"Plaintext",
+	"PrivateKey",
+	"PrivateKeyBase64",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretString",
+	"ServicePassword",
+	"SessionToken",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+}
+
+var (
+	secretFields = func() map[string]struct{} {
+		m := make(map[string]struct{}, len(secretFieldNames))
+		for _, name := range secretFieldNames {
+			m[strings.ToLower(name)] = struct{}{}
+		}
+		return m
+	}()
+
+	secretXMLElementRegexp = func() *regexp.Regexp {
+		names := make([]string, len(secretFieldNames))
+		for i, name := range secretFieldNames {
+			names[i] = regexp.QuoteMeta(name)
+		}
+		return regexp.MustCompile(`(?i)(<(?:` + strings.Join(names, "|") + `)>)[^<]+(</)`)
+	}()
+)
+
+func isSecretField(name string) bool {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	_, ok := secretFields[strings.ToLower(name)]
+
+	return ok
+}
+
+// redactURL replaces the values of secret query parameters, such as those of presigned URLs.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+
+	if !redactValues(query) {
+		return u.String()
+	}
+
+	v := *u
+	v.RawQuery = query.Encode()
+
+	return v.String()
+}
+
+// redactBody replaces secret values in a JSON, XML or form-encoded message body.
+// Bodies in any other format are returned unchanged.
+func redactBody(contentType string, body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+
+	switch {
+	case len(trimmed) == 0:
+		return body
+
+	case trimmed[0] == '{' || trimmed[0] == '[':
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		var v interface{}
+		if err := decoder.Decode(&v); err != nil || !redactJSON(v) {
+			return body
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return body
+		}
+
+		return b
+
+	case trimmed[0] == '<':
+		return secretXMLElementRegexp.ReplaceAll(body, []byte("${1}"+Redacted+"${2}"))
+
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		form, err := url.ParseQuery(string(body))
+		if err != nil || !redactValues(form) {
+			return body
+		}
+
+		return []byte(form.Encode())
+	}
+
+	return body
+}
+
+// redactJSON replaces secret string values in a decoded JSON value, reporting whether any were replaced.
+func redactJSON(v interface{}) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && isSecretField(k) {
+				v[k] = Redacted
+				redacted = true
+				continue
+			}
+
+			if redactJSON(e) {
+				redacted = true
+			}
+		}
+
+	case []interface{}:
+		for _, e := range v {
+			if redactJSON(e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// redactValues replaces secret form or query parameter values, reporting whether any were replaced.
+func redactValues(values url.Values) bool {
+	redacted := false
+
+	for k, v := range values {
+		if !isSecretField(k) {
+			continue
+		}
+
+		for i := range v {
+			v[i] = Redacted
+		}
+		redacted = true
+	}
+
+	return redacted
+}
@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Pool is a named Semaphore that limits the number of concurrent holders of a quota-bound resource,
+// such as Elastic IPs or VPCs, and records how long holders wait in its queue.
+type Pool struct {
+	name      string
+	semaphore Semaphore
+
+	mu    sync.Mutex
+	stats PoolStats
+}
+
+// PoolStats summarizes the queue wait time of a Pool.
+type PoolStats struct {
+	Acquisitions int           // Number of successful acquisitions.
+	Timeouts     int           // Number of waits that ended before the pool could be acquired.
+	TotalWait    time.Duration // Total time spent waiting, including waits that timed out.
+	MaxWait      time.Duration // Longest single wait.
+}
+
+var (
+	registeredPoolsMu sync.Mutex
+	registeredPools   = make(map[string]*Pool)
+)
+
+// NewPool returns a new Pool with a default capacity, or a capacity overridden using an environment variable.
+// The pool is registered by name so that it is included in pool summaries. Pool names must be unique.
+func NewPool(name, envvar string, defaultLimit int) *Pool {
+	pool := &Pool{
+		name:      name,
+		semaphore: InitializeSemaphore(envvar, defaultLimit),
+	}
+
+	registeredPoolsMu.Lock()
+	defer registeredPoolsMu.Unlock()
+
+	if _, ok := registeredPools[name]; ok {
+		panic(fmt.Errorf("duplicate pool name: %q", name))
+	}
+
+	registeredPools[name] = pool
+
+	return pool
+}
+
+// Name returns the pool's name.
+func (p *Pool) Name() string {
+	return p.name
+}
+
+// Capacity returns the maximum number of concurrent holders of the pool.
+func (p *Pool) Capacity() int {
+	return cap(p.semaphore)
+}
+
+// Stats returns a snapshot of the pool's queue wait time statistics.
+func (p *Pool) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.stats
+}
+
+func (p *Pool) acquire(ctx context.Context) error {
+	start := time.Now()
+	err := p.semaphore.WaitContext(ctx)
+	wait := time.Since(start)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.stats.Timeouts++
+	} else {
+		p.stats.Acquisitions++
+	}
+	p.stats.TotalWait += wait
+	if wait > p.stats.MaxWait {
+		p.stats.MaxWait = wait
+	}
+
+	return err
+}
+
+func (p *Pool) release() {
+	p.semaphore.Notify()
+}
+
+// AcquirePools waits until each of the specified pools can be acquired, or until the context is done.
+// Pools are always acquired in name order, whatever the order specified, so that callers acquiring
+// overlapping sets of pools cannot deadlock. Duplicate pools are acquired once.
+// On success, the returned function releases all the pools and must be called exactly once.
+// On failure, any pools already acquired are released and an error naming the pool being waited for is returned.
+func AcquirePools(ctx context.Context, pools ...*Pool) (func(), error) {
+	ordered := make([]*Pool, 0, len(pools))
+	seen := make(map[*Pool]bool, len(pools))
+	for _, pool := range pools {
+		if !seen[pool] {
+			seen[pool] = true
+			ordered = append(ordered, pool)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].name < ordered[j].name
+	})
+
+	release := func(acquired []*Pool) {
+		for i := len(acquired) - 1; i >= 0; i-- {
+			acquired[i].release()
+		}
+	}
+
+	for i, pool := range ordered {
+		if err := pool.acquire(ctx); err != nil {
+			release(ordered[:i])
+
+			return nil, fmt.Errorf("waiting for pool %q: %w", pool.name, err)
+		}
+	}
+
+	return func() { release(ordered) }, nil
+}
+
+// WritePoolSummary writes a summary of queue wait time for each registered pool that has been waited on, sorted by name.
+// Nothing is written if no pool has been waited on.
+func WritePoolSummary(w io.Writer) error {
+	registeredPoolsMu.Lock()
+	pools := make([]*Pool, 0, len(registeredPools))
+	for _, pool := range registeredPools {
+		pools = append(pools, pool)
+	}
+	registeredPoolsMu.Unlock()
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].name < pools[j].name
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	emittedHeader := false
+
+	for _, pool := range pools {
+		stats := pool.Stats()
+		waits := stats.Acquisitions + stats.Timeouts
+
+		if waits == 0 {
+			continue
+		}
+
+		if !emittedHeader {
+			fmt.Fprintln(tw, "POOL\tCAPACITY\tACQUIRED\tTIMED OUT\tTOTAL WAIT\tMAX WAIT\tMEAN WAIT")
+			emittedHeader = true
+		}
+
+		mean := stats.TotalWait / time.Duration(waits)
+
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\t%s\n", pool.name, pool.Capacity(), stats.Acquisitions, stats.Timeouts,
+			stats.TotalWait.Round(time.Millisecond), stats.MaxWait.Round(time.Millisecond), mean.Round(time.Millisecond))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAcquirePools(t *testing.T) {
+	a := NewPool("TestAcquirePoolsA", "TF_TEST_ACQUIRE_POOLS_A_LIMIT", 1)
+	b := NewPool("TestAcquirePoolsB", "TF_TEST_ACQUIRE_POOLS_B_LIMIT", 1)
+
+	// Callers acquiring the same pools in opposite orders must not deadlock.
+	doneCh := make(chan struct{})
+
+	for _, pools := range [][]*Pool{{a, b}, {b, a}, {a, b, a}} {
+		pools := pools
+
+		go func() {
+			for i := 0; i < 10; i++ {
+				release, err := AcquirePools(context.Background(), pools...)
+
+				if err != nil {
+					t.Error(err)
+
+					return
+				}
+
+				release()
+			}
+
+			doneCh <- struct{}{}
+		}()
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-doneCh:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Pools not acquired. Possible deadlock.")
+		}
+	}
+
+	if got, want := a.Stats().Acquisitions, 30; got != want {
+		t.Errorf("pool A acquisitions: got %d, want %d", got, want)
+	}
+}
+
+func TestAcquirePoolsTimeout(t *testing.T) {
+	a := NewPool("TestAcquirePoolsTimeoutA", "TF_TEST_ACQUIRE_POOLS_TIMEOUT_A_LIMIT", 1)
+	b := NewPool("TestAcquirePoolsTimeoutB", "TF_TEST_ACQUIRE_POOLS_TIMEOUT_B_LIMIT", 1)
+
+	releaseB, err := AcquirePools(context.Background(), b)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = AcquirePools(ctx, b, a)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `"TestAcquirePoolsTimeoutB"`) {
+		t.Errorf("expected error to name pool, got %s", err)
+	}
+
+	// Pool A, acquired before waiting for pool B, must have been released.
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	releaseA, err := AcquirePools(ctx, a)
+
+	if err != nil {
+		t.Fatalf("pool A not released: %s", err)
+	}
+
+	releaseA()
+	releaseB()
+
+	if got, want := b.Stats().Timeouts, 1; got != want {
+		t.Errorf("pool B timeouts: got %d, want %d", got, want)
+	}
+}
+
+func TestWritePoolSummary(t *testing.T) {
+	pool := NewPool("TestWritePoolSummary", "TF_TEST_WRITE_POOL_SUMMARY_LIMIT", 2)
+	NewPool("TestWritePoolSummaryUnused", "TF_TEST_WRITE_POOL_SUMMARY_UNUSED_LIMIT", 2)
+
+	release, err := AcquirePools(context.Background(), pool)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	release()
+
+	var sb strings.Builder
+
+	if err := WritePoolSummary(&sb); err != nil {
+		t.Fatal(err)
+	}
+
+	summary := sb.String()
+
+	if !strings.HasPrefix(summary, "POOL") {
+		t.Errorf("expected summary header, got:\n%s", summary)
+	}
+
+	if !strings.Contains(summary, "TestWritePoolSummary ") {
+		t.Errorf("expected summary to contain used pool, got:\n%s", summary)
+	}
+
+	if strings.Contains(summary, "TestWritePoolSummaryUnused") {
+		t.Errorf("expected summary not to contain unused pool, got:\n%s", summary)
+	}
+}
+
+func TestNewPoolDuplicateName(t *testing.T) {
+	NewPool("TestNewPoolDuplicateName", "TF_TEST_NEW_POOL_DUPLICATE_NAME_LIMIT", 1)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for duplicate pool name")
+		}
+	}()
+
+	NewPool("TestNewPoolDuplicateName", "TF_TEST_NEW_POOL_DUPLICATE_NAME_LIMIT", 1)
+}
@@ -0,0 +1,776 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+// "AutoFlEx" is a reflection-based expander and flattener between Terraform Plugin Framework
+// resource models and AWS SDK for Go v2 API structures.
+//
+// Fields are matched by Go field name, ignoring case, and attributes of a types.Object by
+// `tfsdk` name, ignoring case and underscores, e.g. `program_name` matches ProgramName.
+// Every exported model field must have a counterpart in the API structure, or be ignored
+// via WithIgnoredFieldNames; fields of the API structure without a counterpart are left untouched.
+//
+// Supported conversions:
+//
+//   - types.String to and from string, *string and string enumerations
+//   - types.Int64 to and from int64, *int64, int32 and *int32 (and other sized integers)
+//   - types.Bool to and from bool and *bool
+//   - types.Float64 to and from float64, *float64, float32 and *float32
+//   - types.List and types.Set to and from slices of the above, slices of structures
+//     and, for single nested blocks, a structure or pointer to structure
+//   - types.Object to and from a structure or pointer to structure
+//   - types.Map to and from maps with string keys
+//   - fwtypes.Duration to and from time.Duration, *time.Duration, string and *string
+//   - fwtypes.ARN to and from string, *string and arn.ARN
+//   - Go slices of, or pointers to, nested model structures to and from the corresponding API structures
+//
+// Null and unknown values expand to Go zero values (nil pointers and slices).
+// A nil pointer, slice or map flattens to a null value.
+//
+// Flattening into a types.List, types.Set or types.Map of objects, or into a types.Object,
+// requires the target to already carry its element or attribute types, as it does when
+// the model has been read from a plan or state.
+
+// AutoFlexOptions configures Expand and Flatten.
+type AutoFlexOptions struct {
+	ignoredFieldNames map[string]bool
+}
+
+// AutoFlexOptionsFunc configures Expand and Flatten.
+type AutoFlexOptionsFunc func(*AutoFlexOptions)
+
+// WithIgnoredFieldNames ignores the specified model field names, e.g. "ID", "Tags" or "Timeouts", at any level.
+func WithIgnoredFieldNames(names ...string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, name := range names {
+			o.ignoredFieldNames[strings.ToLower(name)] = true
+		}
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) *AutoFlexOptions {
+	o := &AutoFlexOptions{
+		ignoredFieldNames: make(map[string]bool),
+	}
+
+	for _, optFn := range optFns {
+		optFn(o)
+	}
+
+	return o
+}
+
+// Expand copies a Terraform Plugin Framework model (a structure or pointer to structure)
+// into an AWS API structure, which must be a non-nil pointer to structure.
+func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, to, d := autoFlexArguments(tfObject, apiObject)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := &autoExpander{options: newAutoFlexOptions(optFns)}
+	diags.Append(f.structure(ctx, "", from, to)...)
+
+	return diags
+}
+
+// Flatten copies an AWS API structure (a structure or pointer to structure) into a
+// Terraform Plugin Framework model, which must be a non-nil pointer to structure.
+func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	from, to, d := autoFlexArguments(apiObject, tfObject)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := &autoFlattener{options: newAutoFlexOptions(optFns)}
+	diags.Append(f.structure(ctx, "", from, to)...)
+
+	return diags
+}
+
+// autoFlexArguments validates Expand and Flatten arguments, returning the source and target structures.
+func autoFlexArguments(from, to any) (reflect.Value, reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	vFrom := reflect.ValueOf(from)
+	if vFrom.Kind() == reflect.Pointer {
+		if vFrom.IsNil() {
+			diags.AddError("AutoFlEx", "source is a nil pointer")
+			return vFrom, vFrom, diags
+		}
+		vFrom = vFrom.Elem()
+	}
+	if vFrom.Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("source (%T) is not a structure or pointer to structure", from))
+	}
+
+	vTo := reflect.ValueOf(to)
+	if vTo.Kind() != reflect.Pointer || vTo.IsNil() || vTo.Elem().Kind() != reflect.Struct {
+		diags.AddError("AutoFlEx", fmt.Sprintf("target (%T) is not a non-nil pointer to structure", to))
+		return vFrom, vTo, diags
+	}
+
+	return vFrom, vTo.Elem(), diags
+}
+
+// autoFlexField is an exported model field paired with the field of the same name in an API structure.
+type autoFlexField struct {
+	name     string
+	from, to reflect.Value
+}
+
+// autoFlexFields returns the exported model fields of a structure, paired with the
+// fields of the same name in the API structure.
+func autoFlexFields(options *AutoFlexOptions, path string, model, api reflect.Value, modelIsSource bool) ([]autoFlexField, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var fields []autoFlexField
+
+	typ := model.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() || field.Anonymous || options.ignoredFieldNames[strings.ToLower(field.Name)] {
+			continue
+		}
+
+		name := field.Name
+		apiField := api.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(s, name)
+		})
+
+		if !apiField.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("%s: no corresponding field in %s", fieldPath(path, name), api.Type()))
+			continue
+		}
+
+		if modelIsSource {
+			fields = append(fields, autoFlexField{name: name, from: model.Field(i), to: apiField})
+		} else {
+			fields = append(fields, autoFlexField{name: name, from: apiField, to: model.Field(i)})
+		}
+	}
+
+	return fields, diags
+}
+
+// objectFieldByName returns the field of a structure corresponding to an object attribute name.
+func objectFieldByName(v reflect.Value, name string) reflect.Value {
+	name = strings.ReplaceAll(name, "_", "")
+
+	return v.FieldByNameFunc(func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+}
+
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+var (
+	attrValueType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	arnType       = reflect.TypeOf(arn.ARN{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+)
+
+type autoExpander struct {
+	options *AutoFlexOptions
+}
+
+// structure expands a model structure into an API structure.
+func (f *autoExpander) structure(ctx context.Context, path string, from, to reflect.Value) diag.Diagnostics {
+	fields, diags := autoFlexFields(f.options, path, from, to, true)
+
+	for _, field := range fields {
+		diags.Append(f.value(ctx, fieldPath(path, field.name), field.from, field.to)...)
+	}
+
+	return diags
+}
+
+// value expands a model field into an API field.
+func (f *autoExpander) value(ctx context.Context, path string, from, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from.Type().Implements(attrValueType) {
+		return f.attr(ctx, path, from.Interface().(attr.Value), to)
+	}
+
+	switch from.Kind() {
+	case reflect.Pointer:
+		if from.IsNil() {
+			return diags
+		}
+
+		return f.value(ctx, path, from.Elem(), to)
+
+	case reflect.Struct:
+		// Nested model structure.
+		target, ok := allocateStruct(to)
+		if !ok {
+			break
+		}
+
+		return f.structure(ctx, path, from, target)
+
+	case reflect.Slice:
+		// Nested model structures.
+		if from.Type().Elem().Kind() != reflect.Struct && !(from.Type().Elem().Kind() == reflect.Pointer && from.Type().Elem().Elem().Kind() == reflect.Struct) {
+			break
+		}
+
+		if from.IsNil() {
+			return diags
+		}
+
+		if to.Kind() == reflect.Slice {
+			slice := reflect.MakeSlice(to.Type(), from.Len(), from.Len())
+			for i := 0; i < from.Len(); i++ {
+				diags.Append(f.value(ctx, fmt.Sprintf("%s[%d]", path, i), from.Index(i), slice.Index(i))...)
+			}
+			to.Set(slice)
+
+			return diags
+		}
+
+		switch from.Len() {
+		case 0:
+			return diags
+		case 1:
+			return f.value(ctx, path+"[0]", from.Index(0), to)
+		default:
+			diags.AddError("AutoFlEx", fmt.Sprintf("%s: %d elements cannot be expanded into %s", path, from.Len(), to.Type()))
+			return diags
+		}
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot expand %s into %s", path, from.Type(), to.Type()))
+
+	return diags
+}
+
+// attr expands a Terraform Plugin Framework value into an API field.
+func (f *autoExpander) attr(ctx context.Context, path string, from attr.Value, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from.IsNull() || from.IsUnknown() {
+		return diags
+	}
+
+	// Pointers (other than to structures, handled below) are allocated as needed.
+	if to.Kind() == reflect.Pointer && to.Type().Elem().Kind() != reflect.Struct {
+		v := reflect.New(to.Type().Elem())
+		diags.Append(f.attr(ctx, path, from, v.Elem())...)
+		if !diags.HasError() {
+			to.Set(v)
+		}
+
+		return diags
+	}
+
+	switch from := from.(type) {
+	case types.String:
+		if to.Kind() == reflect.String {
+			to.SetString(from.Value)
+			return diags
+		}
+
+	case types.Int64:
+		switch to.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if to.OverflowInt(from.Value) {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: value %d overflows %s", path, from.Value, to.Type()))
+				return diags
+			}
+			to.SetInt(from.Value)
+			return diags
+		}
+
+	case types.Bool:
+		if to.Kind() == reflect.Bool {
+			to.SetBool(from.Value)
+			return diags
+		}
+
+	case types.Float64:
+		switch to.Kind() {
+		case reflect.Float32, reflect.Float64:
+			if to.Kind() == reflect.Float32 && math.Abs(from.Value) > math.MaxFloat32 {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: value %g overflows %s", path, from.Value, to.Type()))
+				return diags
+			}
+			to.SetFloat(from.Value)
+			return diags
+		}
+
+	case fwtypes.Duration:
+		switch {
+		case to.Type() == durationType:
+			to.SetInt(int64(from.Value))
+			return diags
+		case to.Kind() == reflect.String:
+			to.SetString(from.Value.String())
+			return diags
+		}
+
+	case fwtypes.ARN:
+		switch {
+		case to.Type() == arnType:
+			to.Set(reflect.ValueOf(from.Value))
+			return diags
+		case to.Kind() == reflect.String:
+			to.SetString(from.Value.String())
+			return diags
+		}
+
+	case types.List:
+		return f.elements(ctx, path, from.Elems, to)
+
+	case types.Set:
+		return f.elements(ctx, path, from.Elems, to)
+
+	case types.Map:
+		if to.Kind() == reflect.Map && to.Type().Key().Kind() == reflect.String {
+			m := reflect.MakeMapWithSize(to.Type(), len(from.Elems))
+			for k, elem := range from.Elems {
+				v := reflect.New(to.Type().Elem()).Elem()
+				diags.Append(f.attr(ctx, fmt.Sprintf("%s[%q]", path, k), elem, v)...)
+				m.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), v)
+			}
+			to.Set(m)
+
+			return diags
+		}
+
+	case types.Object:
+		target, ok := allocateStruct(to)
+		if !ok {
+			break
+		}
+
+		names := make([]string, 0, len(from.Attrs))
+		for name := range from.Attrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			field := objectFieldByName(target, name)
+			if !field.IsValid() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: no corresponding field in %s", fieldPath(path, name), target.Type()))
+				continue
+			}
+
+			diags.Append(f.attr(ctx, fieldPath(path, name), from.Attrs[name], field)...)
+		}
+
+		return diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot expand %T into %s", path, from, to.Type()))
+
+	return diags
+}
+
+// elements expands the elements of a types.List or types.Set into a slice or, for a single nested block, a structure.
+func (f *autoExpander) elements(ctx context.Context, path string, elems []attr.Value, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if to.Kind() == reflect.Slice {
+		slice := reflect.MakeSlice(to.Type(), len(elems), len(elems))
+		for i, elem := range elems {
+			diags.Append(f.attr(ctx, fmt.Sprintf("%s[%d]", path, i), elem, slice.Index(i))...)
+		}
+		to.Set(slice)
+
+		return diags
+	}
+
+	switch len(elems) {
+	case 0:
+		return diags
+	case 1:
+		return f.attr(ctx, path+"[0]", elems[0], to)
+	default:
+		diags.AddError("AutoFlEx", fmt.Sprintf("%s: %d elements cannot be expanded into %s", path, len(elems), to.Type()))
+		return diags
+	}
+}
+
+// allocateStruct returns the structure that a value, a structure or pointer to structure, refers to,
+// allocating it if necessary.
+func allocateStruct(v reflect.Value) (reflect.Value, bool) {
+	switch {
+	case v.Kind() == reflect.Struct:
+		return v, true
+	case v.Kind() == reflect.Pointer && v.Type().Elem().Kind() == reflect.Struct:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Elem(), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+type autoFlattener struct {
+	options *AutoFlexOptions
+}
+
+// structure flattens an API structure into a model structure.
+func (f *autoFlattener) structure(ctx context.Context, path string, from, to reflect.Value) diag.Diagnostics {
+	fields, diags := autoFlexFields(f.options, path, to, from, false)
+
+	for _, field := range fields {
+		diags.Append(f.value(ctx, fieldPath(path, field.name), field.from, field.to)...)
+	}
+
+	return diags
+}
+
+// value flattens an API field into a model field.
+func (f *autoFlattener) value(ctx context.Context, path string, from, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if to.Type().Implements(attrValueType) {
+		v, d := f.attr(ctx, path, from, to.Interface().(attr.Value).Type(ctx))
+		diags.Append(d...)
+		if !diags.HasError() {
+			to.Set(reflect.ValueOf(v))
+		}
+
+		return diags
+	}
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			to.Set(reflect.Zero(to.Type()))
+			return diags
+		}
+
+		from = from.Elem()
+	}
+
+	switch to.Kind() {
+	case reflect.Struct:
+		// Nested model structure.
+		if from.Kind() == reflect.Struct {
+			return f.structure(ctx, path, from, to)
+		}
+
+	case reflect.Pointer:
+		if to.Type().Elem().Kind() == reflect.Struct && from.Kind() == reflect.Struct {
+			v := reflect.New(to.Type().Elem())
+			diags.Append(f.structure(ctx, path, from, v.Elem())...)
+			to.Set(v)
+
+			return diags
+		}
+
+	case reflect.Slice:
+		// Nested model structures.
+		switch from.Kind() {
+		case reflect.Slice:
+			if from.IsNil() {
+				to.Set(reflect.Zero(to.Type()))
+				return diags
+			}
+
+			slice := reflect.MakeSlice(to.Type(), from.Len(), from.Len())
+			for i := 0; i < from.Len(); i++ {
+				diags.Append(f.value(ctx, fmt.Sprintf("%s[%d]", path, i), from.Index(i), slice.Index(i))...)
+			}
+			to.Set(slice)
+
+			return diags
+
+		case reflect.Struct:
+			slice := reflect.MakeSlice(to.Type(), 1, 1)
+			diags.Append(f.value(ctx, path+"[0]", from, slice.Index(0))...)
+			to.Set(slice)
+
+			return diags
+		}
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot flatten %s into %s", path, from.Type(), to.Type()))
+
+	return diags
+}
+
+// attr flattens an API field into a Terraform Plugin Framework value of the specified type.
+func (f *autoFlattener) attr(ctx context.Context, path string, from reflect.Value, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			return nullValue(ctx, path, typ)
+		}
+
+		from = from.Elem()
+	}
+
+	switch typ := typ.(type) {
+	case types.ListType:
+		if typ.ElemType == nil {
+			typ.ElemType = inferElementType(from)
+		}
+
+		elems, d := f.elements(ctx, path, from, typ.ElemType)
+		diags.Append(d...)
+		if elems == nil {
+			return types.List{ElemType: typ.ElemType, Null: true}, diags
+		}
+
+		return types.List{ElemType: typ.ElemType, Elems: elems}, diags
+
+	case types.SetType:
+		if typ.ElemType == nil {
+			typ.ElemType = inferElementType(from)
+		}
+
+		elems, d := f.elements(ctx, path, from, typ.ElemType)
+		diags.Append(d...)
+		if elems == nil {
+			return types.Set{ElemType: typ.ElemType, Null: true}, diags
+		}
+
+		return types.Set{ElemType: typ.ElemType, Elems: elems}, diags
+
+	case types.MapType:
+		if from.Kind() != reflect.Map || from.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		if typ.ElemType == nil {
+			typ.ElemType = inferElementType(from)
+		}
+
+		if typ.ElemType == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("%s: unknown map element type", path))
+			return nil, diags
+		}
+
+		if from.IsNil() {
+			return types.Map{ElemType: typ.ElemType, Null: true}, diags
+		}
+
+		elems := make(map[string]attr.Value, from.Len())
+		iter := from.MapRange()
+		for iter.Next() {
+			k := iter.Key().String()
+			v, d := f.attr(ctx, fmt.Sprintf("%s[%q]", path, k), iter.Value(), typ.ElemType)
+			diags.Append(d...)
+			elems[k] = v
+		}
+
+		return types.Map{ElemType: typ.ElemType, Elems: elems}, diags
+
+	case types.ObjectType:
+		if from.Kind() != reflect.Struct {
+			break
+		}
+
+		if typ.AttrTypes == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("%s: unknown object attribute types", path))
+			return nil, diags
+		}
+
+		attrs := make(map[string]attr.Value, len(typ.AttrTypes))
+		names := make([]string, 0, len(typ.AttrTypes))
+		for name := range typ.AttrTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			field := objectFieldByName(from, name)
+			if !field.IsValid() {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: no corresponding field in %s", fieldPath(path, name), from.Type()))
+				continue
+			}
+
+			v, d := f.attr(ctx, fieldPath(path, name), field, typ.AttrTypes[name])
+			diags.Append(d...)
+			attrs[name] = v
+		}
+
+		return types.Object{AttrTypes: typ.AttrTypes, Attrs: attrs}, diags
+	}
+
+	switch {
+	case typ.Equal(types.StringType):
+		if from.Kind() == reflect.String {
+			return types.String{Value: from.String()}, diags
+		}
+
+	case typ.Equal(types.Int64Type):
+		switch from.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return types.Int64{Value: from.Int()}, diags
+		}
+
+	case typ.Equal(types.BoolType):
+		if from.Kind() == reflect.Bool {
+			return types.Bool{Value: from.Bool()}, diags
+		}
+
+	case typ.Equal(types.Float64Type):
+		switch from.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return types.Float64{Value: from.Float()}, diags
+		}
+
+	case typ.Equal(fwtypes.DurationType):
+		switch {
+		case from.Type() == durationType:
+			return fwtypes.Duration{Value: time.Duration(from.Int())}, diags
+		case from.Kind() == reflect.String:
+			v, err := time.ParseDuration(from.String())
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: %s", path, err))
+				return nil, diags
+			}
+
+			return fwtypes.Duration{Value: v}, diags
+		}
+
+	case typ.Equal(fwtypes.ARNType):
+		switch {
+		case from.Type() == arnType:
+			return fwtypes.ARN{Value: from.Interface().(arn.ARN)}, diags
+		case from.Kind() == reflect.String:
+			v, err := arn.Parse(from.String())
+			if err != nil {
+				diags.AddError("AutoFlEx", fmt.Sprintf("%s: %s", path, err))
+				return nil, diags
+			}
+
+			return fwtypes.ARN{Value: v}, diags
+		}
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot flatten %s into %s", path, from.Type(), typ))
+
+	return nil, diags
+}
+
+// elements flattens a slice or, for a single nested block, a structure into list or set elements.
+// A nil slice flattens to nil elements.
+func (f *autoFlattener) elements(ctx context.Context, path string, from reflect.Value, elemType attr.Type) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if elemType == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("%s: unknown element type", path))
+		return nil, diags
+	}
+
+	switch from.Kind() {
+	case reflect.Slice:
+		if from.IsNil() {
+			return nil, diags
+		}
+
+		elems := make([]attr.Value, from.Len())
+		for i := 0; i < from.Len(); i++ {
+			v, d := f.attr(ctx, fmt.Sprintf("%s[%d]", path, i), from.Index(i), elemType)
+			diags.Append(d...)
+			elems[i] = v
+		}
+
+		return elems, diags
+
+	case reflect.Struct:
+		v, d := f.attr(ctx, path+"[0]", from, elemType)
+		diags.Append(d...)
+
+		return []attr.Value{v}, diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot flatten %s into elements", path, from.Type()))
+
+	return nil, diags
+}
+
+// inferElementType returns the Terraform Plugin Framework type of the elements of a slice or map of primitives, or nil.
+func inferElementType(v reflect.Value) attr.Type {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Map {
+		return nil
+	}
+
+	typ := v.Type().Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return types.StringType
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return types.Int64Type
+	case reflect.Bool:
+		return types.BoolType
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type
+	default:
+		return nil
+	}
+}
+
+// nullValue returns the null value of a Terraform Plugin Framework type.
+func nullValue(_ context.Context, path string, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch typ := typ.(type) {
+	case types.ListType:
+		return types.List{ElemType: typ.ElemType, Null: true}, diags
+	case types.SetType:
+		return types.Set{ElemType: typ.ElemType, Null: true}, diags
+	case types.MapType:
+		return types.Map{ElemType: typ.ElemType, Null: true}, diags
+	case types.ObjectType:
+		return types.Object{AttrTypes: typ.AttrTypes, Null: true}, diags
+	}
+
+	switch {
+	case typ.Equal(types.StringType):
+		return types.String{Null: true}, diags
+	case typ.Equal(types.Int64Type):
+		return types.Int64{Null: true}, diags
+	case typ.Equal(types.BoolType):
+		return types.Bool{Null: true}, diags
+	case typ.Equal(types.Float64Type):
+		return types.Float64{Null: true}, diags
+	case typ.Equal(fwtypes.DurationType):
+		return fwtypes.Duration{Null: true}, diags
+	case typ.Equal(fwtypes.ARNType):
+		return fwtypes.ARN{Null: true}, diags
+	}
+
+	diags.AddError("AutoFlEx", fmt.Sprintf("%s: cannot make null %s", path, typ))
+
+	return nil, diags
+}
@@ -0,0 +1,613 @@
+package flex
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+type testEnum string
+
+const (
+	testEnumOne testEnum = "ONE"
+	testEnumTwo testEnum = "TWO"
+)
+
+type tfSingleStringField struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+type awsSingleStringValue struct {
+	Field1 string
+}
+
+type awsSingleStringPointer struct {
+	Field1 *string
+}
+
+type awsSingleEnumValue struct {
+	Field1 testEnum
+}
+
+type tfScalars struct {
+	ID       types.String  `tfsdk:"id"`
+	Name     types.String  `tfsdk:"name"`
+	Count    types.Int64   `tfsdk:"count"`
+	Small    types.Int64   `tfsdk:"small"`
+	Enabled  types.Bool    `tfsdk:"enabled"`
+	Ratio    types.Float64 `tfsdk:"ratio"`
+	Interval types.Int64   `tfsdk:"interval"`
+}
+
+type awsScalars struct {
+	Name     *string
+	Count    int64
+	Small    *int32
+	Enabled  *bool
+	Ratio    float64
+	Interval *int64
+	Other    *string
+}
+
+type tfDurationARN struct {
+	Timeout  fwtypes.Duration `tfsdk:"timeout"`
+	Period   fwtypes.Duration `tfsdk:"period"`
+	RoleARN  fwtypes.ARN      `tfsdk:"role_arn"`
+	TopicArn fwtypes.ARN      `tfsdk:"topic_arn"`
+}
+
+type awsDurationARN struct {
+	Timeout  *string
+	Period   time.Duration
+	RoleArn  *string
+	TopicArn arn.ARN
+}
+
+type tfCollections struct {
+	Names   types.List `tfsdk:"names"`
+	Ports   types.Set  `tfsdk:"ports"`
+	Modes   types.List `tfsdk:"modes"`
+	Labels  types.Map  `tfsdk:"labels"`
+	Aliases types.Set  `tfsdk:"aliases"`
+}
+
+type awsCollections struct {
+	Names   []string
+	Ports   []int32
+	Modes   []testEnum
+	Labels  map[string]string
+	Aliases []*string
+}
+
+var testSettingsAttrTypes = map[string]attr.Type{
+	"mode":         types.StringType,
+	"bitrate":      types.Int64Type,
+	"program_name": types.StringType,
+}
+
+type tfNestedObjects struct {
+	Settings types.List   `tfsdk:"settings"`
+	Targets  types.Set    `tfsdk:"targets"`
+	Source   types.Object `tfsdk:"source"`
+}
+
+type awsSettings struct {
+	Mode        testEnum
+	Bitrate     *int32
+	ProgramName *string
+	Unmapped    *string
+}
+
+type awsNestedObjects struct {
+	Settings *awsSettings
+	Targets  []awsSettings
+	Source   *awsSettings
+}
+
+type tfNestedModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type tfNestedModels struct {
+	Single   []tfNestedModel  `tfsdk:"single"`
+	Multiple []tfNestedModel  `tfsdk:"multiple"`
+	Pointer  *tfNestedModel   `tfsdk:"pointer"`
+	Pointers []*tfNestedModel `tfsdk:"pointers"`
+}
+
+type awsNestedModel struct {
+	Name *string
+}
+
+type awsNestedModels struct {
+	Single   *awsNestedModel
+	Multiple []awsNestedModel
+	Pointer  *awsNestedModel
+	Pointers []*awsNestedModel
+}
+
+func testSettingsObject(mode string, bitrate int64, programName string) types.Object {
+	return types.Object{
+		AttrTypes: testSettingsAttrTypes,
+		Attrs: map[string]attr.Value{
+			"mode":         types.String{Value: mode},
+			"bitrate":      types.Int64{Value: bitrate},
+			"program_name": types.String{Value: programName},
+		},
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	testARN := "arn:aws:iam::123456789012:role/test" //lintignore:AWSAT005
+
+	type testCase struct {
+		source        any
+		target        any
+		options       []AutoFlexOptionsFunc
+		expected      any
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"nil source": {
+			source:        (*tfSingleStringField)(nil),
+			target:        &awsSingleStringValue{},
+			expectedError: "source is a nil pointer",
+		},
+		"non-pointer target": {
+			source:        tfSingleStringField{},
+			target:        awsSingleStringValue{},
+			expectedError: "target (flex.awsSingleStringValue) is not a non-nil pointer to structure",
+		},
+		"string to value": {
+			source:   &tfSingleStringField{Field1: types.String{Value: "a"}},
+			target:   &awsSingleStringValue{},
+			expected: &awsSingleStringValue{Field1: "a"},
+		},
+		"string to pointer": {
+			source:   tfSingleStringField{Field1: types.String{Value: "a"}},
+			target:   &awsSingleStringPointer{},
+			expected: &awsSingleStringPointer{Field1: aws.String("a")},
+		},
+		"null string to pointer": {
+			source:   tfSingleStringField{Field1: types.String{Null: true}},
+			target:   &awsSingleStringPointer{},
+			expected: &awsSingleStringPointer{},
+		},
+		"unknown string to pointer": {
+			source:   tfSingleStringField{Field1: types.String{Unknown: true}},
+			target:   &awsSingleStringPointer{},
+			expected: &awsSingleStringPointer{},
+		},
+		"string to enum": {
+			source:   tfSingleStringField{Field1: types.String{Value: string(testEnumOne)}},
+			target:   &awsSingleEnumValue{},
+			expected: &awsSingleEnumValue{Field1: testEnumOne},
+		},
+		"scalars": {
+			source: &tfScalars{
+				ID:       types.String{Value: "id"},
+				Name:     types.String{Value: "test"},
+				Count:    types.Int64{Value: 42},
+				Small:    types.Int64{Value: 7},
+				Enabled:  types.Bool{Value: true},
+				Ratio:    types.Float64{Value: 0.5},
+				Interval: types.Int64{Null: true},
+			},
+			target:  &awsScalars{},
+			options: []AutoFlexOptionsFunc{WithIgnoredFieldNames("ID")},
+			expected: &awsScalars{
+				Name:    aws.String("test"),
+				Count:   42,
+				Small:   aws.Int32(7),
+				Enabled: aws.Bool(true),
+				Ratio:   0.5,
+			},
+		},
+		"unmapped field": {
+			source:        &tfScalars{},
+			target:        &awsScalars{},
+			expectedError: "ID: no corresponding field in flex.awsScalars",
+		},
+		"int32 overflow": {
+			source:        &tfScalars{Small: types.Int64{Value: 1 << 40}},
+			target:        &awsScalars{},
+			options:       []AutoFlexOptionsFunc{WithIgnoredFieldNames("ID")},
+			expectedError: "Small: value 1099511627776 overflows int32",
+		},
+		"incompatible types": {
+			source:        tfSingleStringField{Field1: types.String{Value: "a"}},
+			target:        &struct{ Field1 int64 }{},
+			expectedError: "Field1: cannot expand types.String into int64",
+		},
+		"duration and ARN": {
+			source: &tfDurationARN{
+				Timeout:  fwtypes.Duration{Value: 90 * time.Second},
+				Period:   fwtypes.Duration{Value: time.Hour},
+				RoleARN:  fwtypes.ARN{Value: arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/test"}},
+				TopicArn: fwtypes.ARN{Value: arn.ARN{Partition: "aws", Service: "sns", Region: "us-west-2", AccountID: "123456789012", Resource: "test"}}, //lintignore:AWSAT003
+			},
+			target: &awsDurationARN{},
+			expected: &awsDurationARN{
+				Timeout:  aws.String("1m30s"),
+				Period:   time.Hour,
+				RoleArn:  aws.String(testARN),
+				TopicArn: arn.ARN{Partition: "aws", Service: "sns", Region: "us-west-2", AccountID: "123456789012", Resource: "test"}, //lintignore:AWSAT003
+			},
+		},
+		"collections": {
+			source: &tfCollections{
+				Names: types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}}},
+				Ports: types.Set{ElemType: types.Int64Type, Elems: []attr.Value{types.Int64{Value: 80}, types.Int64{Value: 443}}},
+				Modes: types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: string(testEnumTwo)}}},
+				Labels: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+					"k1": types.String{Value: "v1"},
+				}},
+				Aliases: types.Set{ElemType: types.StringType, Null: true},
+			},
+			target: &awsCollections{},
+			expected: &awsCollections{
+				Names:  []string{"a", "b"},
+				Ports:  []int32{80, 443},
+				Modes:  []testEnum{testEnumTwo},
+				Labels: map[string]string{"k1": "v1"},
+			},
+		},
+		"empty collections": {
+			source: &tfCollections{
+				Names:   types.List{ElemType: types.StringType, Elems: []attr.Value{}},
+				Ports:   types.Set{ElemType: types.Int64Type, Null: true},
+				Modes:   types.List{ElemType: types.StringType, Unknown: true},
+				Labels:  types.Map{ElemType: types.StringType, Null: true},
+				Aliases: types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "x"}}},
+			},
+			target: &awsCollections{},
+			expected: &awsCollections{
+				Names:   []string{},
+				Aliases: []*string{aws.String("x")},
+			},
+		},
+		"nested objects": {
+			source: &tfNestedObjects{
+				Settings: types.List{ElemType: types.ObjectType{AttrTypes: testSettingsAttrTypes}, Elems: []attr.Value{
+					testSettingsObject(string(testEnumOne), 1000, "p1"),
+				}},
+				Targets: types.Set{ElemType: types.ObjectType{AttrTypes: testSettingsAttrTypes}, Elems: []attr.Value{
+					testSettingsObject(string(testEnumOne), 1, "t1"),
+					testSettingsObject(string(testEnumTwo), 2, "t2"),
+				}},
+				Source: types.Object{AttrTypes: testSettingsAttrTypes, Null: true},
+			},
+			target: &awsNestedObjects{},
+			expected: &awsNestedObjects{
+				Settings: &awsSettings{Mode: testEnumOne, Bitrate: aws.Int32(1000), ProgramName: aws.String("p1")},
+				Targets: []awsSettings{
+					{Mode: testEnumOne, Bitrate: aws.Int32(1), ProgramName: aws.String("t1")},
+					{Mode: testEnumTwo, Bitrate: aws.Int32(2), ProgramName: aws.String("t2")},
+				},
+			},
+		},
+		"empty single nested block": {
+			source: &tfNestedObjects{
+				Settings: types.List{ElemType: types.ObjectType{AttrTypes: testSettingsAttrTypes}, Elems: []attr.Value{}},
+				Targets:  types.Set{ElemType: types.ObjectType{AttrTypes: testSettingsAttrTypes}, Null: true},
+				Source:   testSettingsObject(string(testEnumTwo), 5, "s"),
+			},
+			target: &awsNestedObjects{},
+			expected: &awsNestedObjects{
+				Source: &awsSettings{Mode: testEnumTwo, Bitrate: aws.Int32(5), ProgramName: aws.String("s")},
+			},
+		},
+		"too many elements for single nested block": {
+			source: &tfNestedObjects{
+				Settings: types.List{ElemType: types.ObjectType{AttrTypes: testSettingsAttrTypes}, Elems: []attr.Value{
+					testSettingsObject(string(testEnumOne), 1, "a"),
+					testSettingsObject(string(testEnumTwo), 2, "b"),
+				}},
+			},
+			target:        &awsNestedObjects{},
+			expectedError: "Settings: 2 elements cannot be expanded into *flex.awsSettings",
+		},
+		"unmapped object attribute": {
+			source: &tfNestedObjects{
+				Source: types.Object{
+					AttrTypes: map[string]attr.Type{"missing": types.StringType},
+					Attrs:     map[string]attr.Value{"missing": types.String{Value: "x"}},
+				},
+			},
+			target:        &awsNestedObjects{},
+			expectedError: "Source.missing: no corresponding field in flex.awsSettings",
+		},
+		"nested models": {
+			source: &tfNestedModels{
+				Single:   []tfNestedModel{{Name: types.String{Value: "single"}}},
+				Multiple: []tfNestedModel{{Name: types.String{Value: "m1"}}, {Name: types.String{Value: "m2"}}},
+				Pointer:  &tfNestedModel{Name: types.String{Value: "pointer"}},
+				Pointers: []*tfNestedModel{{Name: types.String{Null: true}}},
+			},
+			target: &awsNestedModels{},
+			expected: &awsNestedModels{
+				Single:   &awsNestedModel{Name: aws.String("single")},
+				Multiple: []awsNestedModel{{Name: aws.String("m1")}, {Name: aws.String("m2")}},
+				Pointer:  &awsNestedModel{Name: aws.String("pointer")},
+				Pointers: []*awsNestedModel{{}},
+			},
+		},
+		"nil nested models": {
+			source:   &tfNestedModels{},
+			target:   &awsNestedModels{},
+			expected: &awsNestedModels{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(context.Background(), test.source, test.target, test.options...)
+
+			if test.expectedError != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error %q, got none", test.expectedError)
+				}
+
+				if got := diags.Errors()[0].Detail(); !strings.Contains(got, test.expectedError) {
+					t.Errorf("expected error %q, got %q", test.expectedError, got)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(test.target, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	testARN := "arn:aws:iam::123456789012:role/test" //lintignore:AWSAT005
+	testSettingsElemType := types.ObjectType{AttrTypes: testSettingsAttrTypes}
+
+	type testCase struct {
+		source        any
+		target        any
+		options       []AutoFlexOptionsFunc
+		expected      any
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"nil target": {
+			source:        &awsSingleStringValue{},
+			target:        (*tfSingleStringField)(nil),
+			expectedError: "target (*flex.tfSingleStringField) is not a non-nil pointer to structure",
+		},
+		"value to string": {
+			source:   awsSingleStringValue{Field1: "a"},
+			target:   &tfSingleStringField{},
+			expected: &tfSingleStringField{Field1: types.String{Value: "a"}},
+		},
+		"pointer to string": {
+			source:   &awsSingleStringPointer{Field1: aws.String("a")},
+			target:   &tfSingleStringField{},
+			expected: &tfSingleStringField{Field1: types.String{Value: "a"}},
+		},
+		"nil pointer to string": {
+			source:   &awsSingleStringPointer{},
+			target:   &tfSingleStringField{},
+			expected: &tfSingleStringField{Field1: types.String{Null: true}},
+		},
+		"enum to string": {
+			source:   &awsSingleEnumValue{Field1: testEnumTwo},
+			target:   &tfSingleStringField{},
+			expected: &tfSingleStringField{Field1: types.String{Value: string(testEnumTwo)}},
+		},
+		"scalars": {
+			source: &awsScalars{
+				Name:    aws.String("test"),
+				Count:   42,
+				Small:   aws.Int32(7),
+				Enabled: aws.Bool(false),
+				Ratio:   0.25,
+			},
+			target:  &tfScalars{ID: types.String{Value: "unchanged"}},
+			options: []AutoFlexOptionsFunc{WithIgnoredFieldNames("id")},
+			expected: &tfScalars{
+				ID:       types.String{Value: "unchanged"},
+				Name:     types.String{Value: "test"},
+				Count:    types.Int64{Value: 42},
+				Small:    types.Int64{Value: 7},
+				Enabled:  types.Bool{Value: false},
+				Ratio:    types.Float64{Value: 0.25},
+				Interval: types.Int64{Null: true},
+			},
+		},
+		"unmapped field": {
+			source:        &awsScalars{},
+			target:        &tfScalars{},
+			expectedError: "ID: no corresponding field in flex.awsScalars",
+		},
+		"incompatible types": {
+			source:        &struct{ Field1 bool }{},
+			target:        &tfSingleStringField{},
+			expectedError: "Field1: cannot flatten bool into types.StringType",
+		},
+		"duration and ARN": {
+			source: &awsDurationARN{
+				Timeout: aws.String("1m30s"),
+				Period:  time.Hour,
+				RoleArn: aws.String(testARN),
+			},
+			target: &tfDurationARN{},
+			expected: &tfDurationARN{
+				Timeout:  fwtypes.Duration{Value: 90 * time.Second},
+				Period:   fwtypes.Duration{Value: time.Hour},
+				RoleARN:  fwtypes.ARN{Value: arn.ARN{Partition: "aws", Service: "iam", AccountID: "123456789012", Resource: "role/test"}},
+				TopicArn: fwtypes.ARN{Value: arn.ARN{}},
+			},
+		},
+		"invalid ARN": {
+			source:        &awsDurationARN{RoleArn: aws.String("invalid")},
+			target:        &tfDurationARN{},
+			expectedError: "RoleARN: arn: invalid prefix",
+		},
+		"collections": {
+			source: &awsCollections{
+				Names:   []string{"a", "b"},
+				Ports:   []int32{80},
+				Modes:   []testEnum{testEnumOne},
+				Labels:  map[string]string{"k1": "v1"},
+				Aliases: []*string{},
+			},
+			target: &tfCollections{},
+			expected: &tfCollections{
+				Names:   types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "a"}, types.String{Value: "b"}}},
+				Ports:   types.Set{ElemType: types.Int64Type, Elems: []attr.Value{types.Int64{Value: 80}}},
+				Modes:   types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: string(testEnumOne)}}},
+				Labels:  types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{"k1": types.String{Value: "v1"}}},
+				Aliases: types.Set{ElemType: types.StringType, Elems: []attr.Value{}},
+			},
+		},
+		"nil collections": {
+			source: &awsCollections{},
+			target: &tfCollections{},
+			expected: &tfCollections{
+				Names:   types.List{ElemType: types.StringType, Null: true},
+				Ports:   types.Set{ElemType: types.Int64Type, Null: true},
+				Modes:   types.List{ElemType: types.StringType, Null: true},
+				Labels:  types.Map{ElemType: types.StringType, Null: true},
+				Aliases: types.Set{ElemType: types.StringType, Null: true},
+			},
+		},
+		"nested objects": {
+			source: &awsNestedObjects{
+				Settings: &awsSettings{Mode: testEnumOne, Bitrate: aws.Int32(1000), ProgramName: aws.String("p1")},
+				Targets: []awsSettings{
+					{Mode: testEnumTwo, Bitrate: aws.Int32(2), ProgramName: aws.String("t2")},
+				},
+			},
+			target: &tfNestedObjects{
+				Settings: types.List{ElemType: testSettingsElemType, Null: true},
+				Targets:  types.Set{ElemType: testSettingsElemType, Null: true},
+				Source:   types.Object{AttrTypes: testSettingsAttrTypes, Null: true},
+			},
+			expected: &tfNestedObjects{
+				Settings: types.List{ElemType: testSettingsElemType, Elems: []attr.Value{
+					testSettingsObject(string(testEnumOne), 1000, "p1"),
+				}},
+				Targets: types.Set{ElemType: testSettingsElemType, Elems: []attr.Value{
+					testSettingsObject(string(testEnumTwo), 2, "t2"),
+				}},
+				Source: types.Object{AttrTypes: testSettingsAttrTypes, Null: true},
+			},
+		},
+		"nested objects without element type": {
+			source: &awsNestedObjects{
+				Targets: []awsSettings{{}},
+			},
+			target:        &tfNestedObjects{},
+			expectedError: "Targets: unknown element type",
+		},
+		"nested models": {
+			source: &awsNestedModels{
+				Single:   &awsNestedModel{Name: aws.String("single")},
+				Multiple: []awsNestedModel{{Name: aws.String("m1")}, {Name: aws.String("m2")}},
+				Pointer:  &awsNestedModel{Name: aws.String("pointer")},
+				Pointers: []*awsNestedModel{{}},
+			},
+			target: &tfNestedModels{},
+			expected: &tfNestedModels{
+				Single:   []tfNestedModel{{Name: types.String{Value: "single"}}},
+				Multiple: []tfNestedModel{{Name: types.String{Value: "m1"}}, {Name: types.String{Value: "m2"}}},
+				Pointer:  &tfNestedModel{Name: types.String{Value: "pointer"}},
+				Pointers: []*tfNestedModel{{Name: types.String{Null: true}}},
+			},
+		},
+		"nil nested models": {
+			source:   &awsNestedModels{},
+			target:   &tfNestedModels{Pointer: &tfNestedModel{}},
+			expected: &tfNestedModels{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(context.Background(), test.source, test.target, test.options...)
+
+			if test.expectedError != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error %q, got none", test.expectedError)
+				}
+
+				if got := diags.Errors()[0].Detail(); !strings.Contains(got, test.expectedError) {
+					t.Errorf("expected error %q, got %q", test.expectedError, got)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(test.target, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testSettingsElemType := types.ObjectType{AttrTypes: testSettingsAttrTypes}
+
+	source := &tfNestedObjects{
+		Settings: types.List{ElemType: testSettingsElemType, Elems: []attr.Value{
+			testSettingsObject(string(testEnumOne), 1000, "p1"),
+		}},
+		Targets: types.Set{ElemType: testSettingsElemType, Elems: []attr.Value{
+			testSettingsObject(string(testEnumOne), 1, "t1"),
+			testSettingsObject(string(testEnumTwo), 2, "t2"),
+		}},
+		Source: testSettingsObject(string(testEnumTwo), 3, "s"),
+	}
+
+	var apiObject awsNestedObjects
+	if diags := Expand(ctx, source, &apiObject); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	target := &tfNestedObjects{
+		Settings: types.List{ElemType: testSettingsElemType},
+		Targets:  types.Set{ElemType: testSettingsElemType},
+		Source:   types.Object{AttrTypes: testSettingsAttrTypes},
+	}
+	if diags := Flatten(ctx, &apiObject, target); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if diff := cmp.Diff(target, source); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
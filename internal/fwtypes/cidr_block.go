@@ -0,0 +1,177 @@
+package fwtypes
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type cidrBlockType uint8
+
+const (
+	CIDRBlockType cidrBlockType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = CIDRBlockType
+	_ SemanticEqualityValue  = CIDRBlock{}
+)
+
+func (t cidrBlockType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t cidrBlockType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return CIDRBlock{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return CIDRBlock{Null: true}, nil
+	}
+	s, err := stringFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return CIDRBlock{Value: s}, nil
+}
+
+func (t cidrBlockType) ValueType(context.Context) attr.Value {
+	return CIDRBlock{}
+}
+
+// Equal returns true if `o` is also a CIDRBlockType.
+func (t cidrBlockType) Equal(o attr.Type) bool {
+	_, ok := o.(cidrBlockType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t cidrBlockType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the CIDRBlockType.
+func (t cidrBlockType) String() string {
+	return "types.CIDRBlockType"
+}
+
+// Validate implements type validation.
+func (t cidrBlockType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	value, diags, ok := validateString(in, path, "CIDR Block Type Validation Error")
+
+	if !ok {
+		return diags
+	}
+
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"CIDR Block Type Validation Error",
+			fmt.Sprintf("Value %q cannot be parsed as a CIDR block.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t cidrBlockType) Description() string {
+	return `An IPv4 or IPv6 CIDR block.`
+}
+
+// CIDRBlock is an IPv4 or IPv6 CIDR block.
+// IPv6 CIDR blocks have multiple valid representations, which are semantically equal.
+type CIDRBlock struct {
+	Unknown bool
+	Null    bool
+	Value   string
+}
+
+func (c CIDRBlock) Type(_ context.Context) attr.Type {
+	return CIDRBlockType
+}
+
+func (c CIDRBlock) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := CIDRBlockType.TerraformType(ctx)
+	if c.Null {
+		return tftypes.NewValue(t, nil), nil
+	}
+	if c.Unknown {
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	}
+	return tftypes.NewValue(t, c.Value), nil
+}
+
+// Equal returns true if `other` is a CIDRBlock and has the same value as `c`.
+func (c CIDRBlock) Equal(other attr.Value) bool {
+	o, ok := other.(CIDRBlock)
+	if !ok {
+		return false
+	}
+	if c.Unknown != o.Unknown {
+		return false
+	}
+	if c.Null != o.Null {
+		return false
+	}
+	return c.Value == o.Value
+}
+
+// SemanticEquals returns true if `other` is a CIDRBlock with the same IP address and network as `c`.
+func (c CIDRBlock) SemanticEquals(other attr.Value) bool {
+	if c.Equal(other) {
+		return true
+	}
+
+	o, ok := other.(CIDRBlock)
+	if !ok || c.Unknown || c.Null || o.Unknown || o.Null {
+		return false
+	}
+
+	ip1, ipnet1, err := net.ParseCIDR(c.Value)
+	if err != nil {
+		return false
+	}
+	ip2, ipnet2, err := net.ParseCIDR(o.Value)
+	if err != nil {
+		return false
+	}
+
+	return ip1.Equal(ip2) && ipnet1.String() == ipnet2.String()
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (c CIDRBlock) IsNull() bool {
+	return c.Null
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (c CIDRBlock) IsUnknown() bool {
+	return c.Unknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (c CIDRBlock) String() string {
+	if c.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if c.IsNull() {
+		return attr.NullValueString
+	}
+
+	return c.Value
+}
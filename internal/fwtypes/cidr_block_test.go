@@ -0,0 +1,122 @@
+package fwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+func TestCIDRBlockTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid IPv4": {
+			val: tftypes.NewValue(tftypes.String, "10.0.0.0/16"),
+		},
+		"valid IPv6": {
+			val: tftypes.NewValue(tftypes.String, "2001:db8::/32"),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+		"invalid IP address": {
+			val:         tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			diags := fwtypes.CIDRBlockType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestCIDRBlockSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.CIDRBlock
+		other    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"null": {
+			val:      fwtypes.CIDRBlock{Null: true},
+			other:    fwtypes.CIDRBlock{Null: true},
+			expected: true,
+		},
+		"null and unknown": {
+			val:   fwtypes.CIDRBlock{Null: true},
+			other: fwtypes.CIDRBlock{Unknown: true},
+		},
+		"different type": {
+			val:   fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			other: fwtypes.Duration{},
+		},
+		"equal": {
+			val:      fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			other:    fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			expected: true,
+		},
+		"IPv6 equivalent": {
+			val:      fwtypes.CIDRBlock{Value: "2001:0db8:0000::/32"},
+			other:    fwtypes.CIDRBlock{Value: "2001:db8::/32"},
+			expected: true,
+		},
+		"IPv4 different": {
+			val:      fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			other:    fwtypes.CIDRBlock{Value: "10.1.0.0/16"},
+			expected: false,
+		},
+		"IPv6 different prefix": {
+			val:      fwtypes.CIDRBlock{Value: "2001:db8::/32"},
+			other:    fwtypes.CIDRBlock{Value: "2001:db8::/48"},
+			expected: false,
+		},
+		"invalid": {
+			val:      fwtypes.CIDRBlock{Value: "not ok"},
+			other:    fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			if got := test.val.SemanticEquals(test.other); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package fwtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type iamPolicyType uint8
+
+const (
+	IAMPolicyType iamPolicyType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = IAMPolicyType
+	_ SemanticEqualityValue  = IAMPolicy{}
+)
+
+func (t iamPolicyType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t iamPolicyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return IAMPolicy{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return IAMPolicy{Null: true}, nil
+	}
+	s, err := stringFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return IAMPolicy{Value: s}, nil
+}
+
+func (t iamPolicyType) ValueType(context.Context) attr.Value {
+	return IAMPolicy{}
+}
+
+// Equal returns true if `o` is also a IAMPolicyType.
+func (t iamPolicyType) Equal(o attr.Type) bool {
+	_, ok := o.(iamPolicyType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t iamPolicyType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the IAMPolicyType.
+func (t iamPolicyType) String() string {
+	return "types.IAMPolicyType"
+}
+
+// Validate implements type validation.
+func (t iamPolicyType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	value, diags, ok := validateString(in, path, "IAM Policy Type Validation Error")
+
+	if !ok {
+		return diags
+	}
+
+	if !isJSONObject(value) {
+		diags.AddAttributeError(
+			path,
+			"IAM Policy Type Validation Error",
+			fmt.Sprintf("Value %q is not a valid JSON policy document.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t iamPolicyType) Description() string {
+	return `An IAM policy document in JSON format.`
+}
+
+// IAMPolicy is an IAM policy document in JSON format.
+// Policy documents that differ only in formatting, statement order or the use of single-element arrays are semantically equal.
+type IAMPolicy struct {
+	Unknown bool
+	Null    bool
+	Value   string
+}
+
+func (p IAMPolicy) Type(_ context.Context) attr.Type {
+	return IAMPolicyType
+}
+
+func (p IAMPolicy) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := IAMPolicyType.TerraformType(ctx)
+	if p.Null {
+		return tftypes.NewValue(t, nil), nil
+	}
+	if p.Unknown {
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	}
+	return tftypes.NewValue(t, p.Value), nil
+}
+
+// Equal returns true if `other` is a IAMPolicy and has the same value as `p`.
+func (p IAMPolicy) Equal(other attr.Value) bool {
+	o, ok := other.(IAMPolicy)
+	if !ok {
+		return false
+	}
+	if p.Unknown != o.Unknown {
+		return false
+	}
+	if p.Null != o.Null {
+		return false
+	}
+	return p.Value == o.Value
+}
+
+// SemanticEquals returns true if `other` is an IAMPolicy with a policy document equivalent to that of `p`.
+// An empty policy document is equivalent to `{}`.
+func (p IAMPolicy) SemanticEquals(other attr.Value) bool {
+	if p.Equal(other) {
+		return true
+	}
+
+	o, ok := other.(IAMPolicy)
+	if !ok || p.Unknown || p.Null || o.Unknown || o.Null {
+		return false
+	}
+
+	v1, v2 := strings.TrimSpace(p.Value), strings.TrimSpace(o.Value)
+	if (v1 == "" || v1 == "{}") && (v2 == "" || v2 == "{}") {
+		return true
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(v1, v2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (p IAMPolicy) IsNull() bool {
+	return p.Null
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (p IAMPolicy) IsUnknown() bool {
+	return p.Unknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (p IAMPolicy) String() string {
+	if p.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if p.IsNull() {
+		return attr.NullValueString
+	}
+
+	return p.Value
+}
+
+// isJSONObject returns true if the value is a valid JSON object.
+func isJSONObject(value string) bool {
+	var v map[string]interface{}
+
+	return json.Unmarshal([]byte(value), &v) == nil && v != nil
+}
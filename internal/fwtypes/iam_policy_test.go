@@ -0,0 +1,116 @@
+package fwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+func TestIAMPolicyTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid policy": {
+			val: tftypes.NewValue(tftypes.String, `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+		"invalid JSON array": {
+			val:         tftypes.NewValue(tftypes.String, "[]"),
+			expectError: true,
+		},
+		"invalid JSON null": {
+			val:         tftypes.NewValue(tftypes.String, "null"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			diags := fwtypes.IAMPolicyType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestIAMPolicySemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.IAMPolicy
+		other    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"null": {
+			val:      fwtypes.IAMPolicy{Null: true},
+			other:    fwtypes.IAMPolicy{Null: true},
+			expected: true,
+		},
+		"null and unknown": {
+			val:   fwtypes.IAMPolicy{Null: true},
+			other: fwtypes.IAMPolicy{Unknown: true},
+		},
+		"different type": {
+			val:   fwtypes.IAMPolicy{Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`},
+			other: fwtypes.Duration{},
+		},
+		"empty and empty object": {
+			val:      fwtypes.IAMPolicy{Value: ""},
+			other:    fwtypes.IAMPolicy{Value: "{}"},
+			expected: true,
+		},
+		"reformatted": {
+			val: fwtypes.IAMPolicy{Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`},
+			other: fwtypes.IAMPolicy{Value: `{
+  "Statement": {"Resource": ["*"], "Action": ["s3:GetObject"], "Effect": "Allow"},
+  "Version": "2012-10-17"
+}`},
+			expected: true,
+		},
+		"different": {
+			val:      fwtypes.IAMPolicy{Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`},
+			other:    fwtypes.IAMPolicy{Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			if got := test.val.SemanticEquals(test.other); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
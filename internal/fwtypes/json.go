@@ -0,0 +1,177 @@
+package fwtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type jsonType uint8
+
+const (
+	JSONType jsonType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = JSONType
+	_ SemanticEqualityValue  = JSON{}
+)
+
+func (t jsonType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return JSON{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return JSON{Null: true}, nil
+	}
+	s, err := stringFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return JSON{Value: s}, nil
+}
+
+func (t jsonType) ValueType(context.Context) attr.Value {
+	return JSON{}
+}
+
+// Equal returns true if `o` is also a JSONType.
+func (t jsonType) Equal(o attr.Type) bool {
+	_, ok := o.(jsonType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t jsonType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the JSONType.
+func (t jsonType) String() string {
+	return "types.JSONType"
+}
+
+// Validate implements type validation.
+func (t jsonType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	value, diags, ok := validateString(in, path, "JSON Type Validation Error")
+
+	if !ok {
+		return diags
+	}
+
+	if !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			path,
+			"JSON Type Validation Error",
+			fmt.Sprintf("Value %q is not valid JSON.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t jsonType) Description() string {
+	return `A JSON document.`
+}
+
+// JSON is a JSON document.
+// Documents that differ only in formatting or object key order are semantically equal.
+type JSON struct {
+	Unknown bool
+	Null    bool
+	Value   string
+}
+
+func (j JSON) Type(_ context.Context) attr.Type {
+	return JSONType
+}
+
+func (j JSON) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	t := JSONType.TerraformType(ctx)
+	if j.Null {
+		return tftypes.NewValue(t, nil), nil
+	}
+	if j.Unknown {
+		return tftypes.NewValue(t, tftypes.UnknownValue), nil
+	}
+	return tftypes.NewValue(t, j.Value), nil
+}
+
+// Equal returns true if `other` is a JSON and has the same value as `j`.
+func (j JSON) Equal(other attr.Value) bool {
+	o, ok := other.(JSON)
+	if !ok {
+		return false
+	}
+	if j.Unknown != o.Unknown {
+		return false
+	}
+	if j.Null != o.Null {
+		return false
+	}
+	return j.Value == o.Value
+}
+
+// SemanticEquals returns true if `other` is a JSON with a document equivalent to that of `j`.
+func (j JSON) SemanticEquals(other attr.Value) bool {
+	if j.Equal(other) {
+		return true
+	}
+
+	o, ok := other.(JSON)
+	if !ok || j.Unknown || j.Null || o.Unknown || o.Null {
+		return false
+	}
+
+	var v1, v2 interface{}
+	if err := json.Unmarshal([]byte(j.Value), &v1); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(o.Value), &v2); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(v1, v2)
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (j JSON) IsNull() bool {
+	return j.Null
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (j JSON) IsUnknown() bool {
+	return j.Unknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (j JSON) String() string {
+	if j.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if j.IsNull() {
+		return attr.NullValueString
+	}
+
+	return j.Value
+}
@@ -0,0 +1,116 @@
+package fwtypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+func TestJSONTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid object": {
+			val: tftypes.NewValue(tftypes.String, `{"a": 1}`),
+		},
+		"valid array": {
+			val: tftypes.NewValue(tftypes.String, `[1, 2]`),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			diags := fwtypes.JSONType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestJSONSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.JSON
+		other    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"null": {
+			val:      fwtypes.JSON{Null: true},
+			other:    fwtypes.JSON{Null: true},
+			expected: true,
+		},
+		"null and unknown": {
+			val:   fwtypes.JSON{Null: true},
+			other: fwtypes.JSON{Unknown: true},
+		},
+		"different type": {
+			val:   fwtypes.JSON{Value: `{"a": 1}`},
+			other: fwtypes.Duration{},
+		},
+		"reformatted": {
+			val: fwtypes.JSON{Value: `{"a":1,"b":[true,null]}`},
+			other: fwtypes.JSON{Value: `{
+  "b": [true, null],
+  "a": 1
+}`},
+			expected: true,
+		},
+		"different": {
+			val:      fwtypes.JSON{Value: `{"a":1}`},
+			other:    fwtypes.JSON{Value: `{"a":2}`},
+			expected: false,
+		},
+		"array order": {
+			val:      fwtypes.JSON{Value: `[1,2]`},
+			other:    fwtypes.JSON{Value: `[2,1]`},
+			expected: false,
+		},
+		"invalid": {
+			val:      fwtypes.JSON{Value: "not ok"},
+			other:    fwtypes.JSON{Value: `{"a":1}`},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			if got := test.val.SemanticEquals(test.other); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
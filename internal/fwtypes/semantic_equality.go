@@ -0,0 +1,73 @@
+package fwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SemanticEqualityValue is implemented by values which have several equivalent representations,
+// e.g. IPv6 CIDR blocks or JSON documents.
+type SemanticEqualityValue interface {
+	attr.Value
+
+	// SemanticEquals returns true if `other` represents the same value, even if its representation differs.
+	SemanticEquals(other attr.Value) bool
+}
+
+// SemanticValue returns `prior` if it is semantically equal to `new`, otherwise `new`.
+// Use it when refreshing a value from the AWS API so that an equivalent value returned in
+// a different form does not cause a spurious difference from the configuration.
+func SemanticValue[T SemanticEqualityValue](prior, new T) T {
+	if prior.SemanticEquals(new) {
+		return prior
+	}
+
+	return new
+}
+
+// validateString ensures that the Terraform value is a String and returns its value.
+// Returns false if the value is not a String, is unknown or is null.
+func validateString(in tftypes.Value, path path.Path, summary string) (string, diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			summary,
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return "", diags, false
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return "", diags, false
+	}
+
+	var value string
+	err := in.As(&value)
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			summary,
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return "", diags, false
+	}
+
+	return value, diags, true
+}
+
+// stringFromTerraform returns the Go string of a known, non-null Terraform String value.
+func stringFromTerraform(_ context.Context, in tftypes.Value) (string, error) {
+	var s string
+	err := in.As(&s)
+
+	return s, err
+}
@@ -0,0 +1,196 @@
+package fwtypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type timestampType uint8
+
+const (
+	TimestampType timestampType = iota
+)
+
+var (
+	_ xattr.TypeWithValidate = TimestampType
+	_ SemanticEqualityValue  = Timestamp{}
+)
+
+func (t timestampType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (t timestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Timestamp{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return Timestamp{Null: true}, nil
+	}
+	s, err := stringFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return Timestamp{Value: s}, nil
+}
+
+func (t timestampType) ValueType(context.Context) attr.Value {
+	return Timestamp{}
+}
+
+// Equal returns true if `o` is also a TimestampType.
+func (t timestampType) Equal(o attr.Type) bool {
+	_, ok := o.(timestampType)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t timestampType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// String returns a human-friendly description of the TimestampType.
+func (t timestampType) String() string {
+	return "types.TimestampType"
+}
+
+// Validate implements type validation.
+func (t timestampType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	value, diags, ok := validateString(in, path, "Timestamp Type Validation Error")
+
+	if !ok {
+		return diags
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Timestamp Type Validation Error",
+			fmt.Sprintf("Value %q cannot be parsed as an RFC3339 timestamp.", value),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+func (t timestampType) Description() string {
+	return `An RFC3339 timestamp, e.g. "2006-01-02T15:04:05Z".`
+}
+
+// Timestamp is an RFC3339 timestamp.
+// Timestamps that represent the same instant, e.g. in different time zones, are semantically equal.
+type Timestamp struct {
+	Unknown bool
+	Null    bool
+	Value   string
+}
+
+// TimestampValue returns a known Timestamp for the specified time, formatted as RFC3339.
+func TimestampValue(v time.Time) Timestamp {
+	return Timestamp{Value: v.Format(time.RFC3339)}
+}
+
+func (t Timestamp) Type(_ context.Context) attr.Type {
+	return TimestampType
+}
+
+func (t Timestamp) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	typ := TimestampType.TerraformType(ctx)
+	if t.Null {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if t.Unknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	return tftypes.NewValue(typ, t.Value), nil
+}
+
+// Equal returns true if `other` is a Timestamp and has the same value as `t`.
+func (t Timestamp) Equal(other attr.Value) bool {
+	o, ok := other.(Timestamp)
+	if !ok {
+		return false
+	}
+	if t.Unknown != o.Unknown {
+		return false
+	}
+	if t.Null != o.Null {
+		return false
+	}
+	return t.Value == o.Value
+}
+
+// SemanticEquals returns true if `other` is a Timestamp representing the same instant as `t`.
+func (t Timestamp) SemanticEquals(other attr.Value) bool {
+	if t.Equal(other) {
+		return true
+	}
+
+	o, ok := other.(Timestamp)
+	if !ok || t.Unknown || t.Null || o.Unknown || o.Null {
+		return false
+	}
+
+	t1, err := time.Parse(time.RFC3339, t.Value)
+	if err != nil {
+		return false
+	}
+	t2, err := time.Parse(time.RFC3339, o.Value)
+	if err != nil {
+		return false
+	}
+
+	return t1.Equal(t2)
+}
+
+// ValueTime returns the time represented by `t`, or the zero time if `t` is unknown, null or invalid.
+func (t Timestamp) ValueTime() time.Time {
+	if t.Unknown || t.Null {
+		return time.Time{}
+	}
+
+	v, err := time.Parse(time.RFC3339, t.Value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return v
+}
+
+// IsNull returns true if the Value is not set, or is explicitly set to null.
+func (t Timestamp) IsNull() bool {
+	return t.Null
+}
+
+// IsUnknown returns true if the Value is not yet known.
+func (t Timestamp) IsUnknown() bool {
+	return t.Unknown
+}
+
+// String returns a summary representation of either the underlying Value,
+// or UnknownValueString (`<unknown>`) when IsUnknown() returns true,
+// or NullValueString (`<null>`) when IsNull() return true.
+//
+// This is an intentionally lossy representation, that are best suited for
+// logging and error reporting, as they are not protected by
+// compatibility guarantees within the framework.
+func (t Timestamp) String() string {
+	if t.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if t.IsNull() {
+		return attr.NullValueString
+	}
+
+	return t.Value
+}
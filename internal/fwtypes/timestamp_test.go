@@ -0,0 +1,132 @@
+package fwtypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+)
+
+func TestTimestampTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a string": {
+			val:         tftypes.NewValue(tftypes.Bool, true),
+			expectError: true,
+		},
+		"unknown string": {
+			val: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"null string": {
+			val: tftypes.NewValue(tftypes.String, nil),
+		},
+		"valid UTC": {
+			val: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+		"valid offset": {
+			val: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
+		},
+		"invalid string": {
+			val:         tftypes.NewValue(tftypes.String, "not ok"),
+			expectError: true,
+		},
+		"invalid date": {
+			val:         tftypes.NewValue(tftypes.String, "2006-01-02"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			diags := fwtypes.TimestampType.Validate(ctx, test.val, path.Root("test"))
+
+			if !diags.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if diags.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %#v", diags)
+			}
+		})
+	}
+}
+
+func TestTimestampSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.Timestamp
+		other    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"null": {
+			val:      fwtypes.Timestamp{Null: true},
+			other:    fwtypes.Timestamp{Null: true},
+			expected: true,
+		},
+		"null and unknown": {
+			val:   fwtypes.Timestamp{Null: true},
+			other: fwtypes.Timestamp{Unknown: true},
+		},
+		"different type": {
+			val:   fwtypes.Timestamp{Value: "2006-01-02T15:04:05Z"},
+			other: fwtypes.Duration{},
+		},
+		"different time zones": {
+			val:      fwtypes.Timestamp{Value: "2006-01-02T15:04:05Z"},
+			other:    fwtypes.Timestamp{Value: "2006-01-02T16:04:05+01:00"},
+			expected: true,
+		},
+		"fractional seconds": {
+			val:      fwtypes.Timestamp{Value: "2006-01-02T15:04:05Z"},
+			other:    fwtypes.Timestamp{Value: "2006-01-02T15:04:05.000Z"},
+			expected: true,
+		},
+		"different": {
+			val:      fwtypes.Timestamp{Value: "2006-01-02T15:04:05Z"},
+			other:    fwtypes.Timestamp{Value: "2006-01-02T15:04:06Z"},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			if got := test.val.SemanticEquals(test.other); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestTimestampValue(t *testing.T) {
+	t.Parallel()
+
+	v := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	ts := fwtypes.TimestampValue(v)
+
+	if got, expected := ts.Value, "2006-01-02T15:04:05Z"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	if got := ts.ValueTime(); !got.Equal(v) {
+		t.Errorf("got %s, expected %s", got, v)
+	}
+
+	if got := (fwtypes.Timestamp{Null: true}).ValueTime(); !got.IsZero() {
+		t.Errorf("got %s, expected zero time", got)
+	}
+}
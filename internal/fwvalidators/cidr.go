@@ -0,0 +1,38 @@
+package fwvalidators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// IPv4CIDRNetworkAddress returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, or a fwtypes.CIDRBlock.
+//   - Is an IPv4 CIDR block whose IP address is the network address, e.g. `10.0.0.0/16`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IPv4CIDRNetworkAddress() tfsdk.AttributeValidator {
+	return stringValidator{
+		description: "value must be a valid IPv4 CIDR network address",
+		valid: func(s string) bool {
+			return verify.ValidateIPv4CIDRBlock(s) == nil
+		},
+	}
+}
+
+// IPv6CIDRNetworkAddress returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, or a fwtypes.CIDRBlock.
+//   - Is an IPv6 CIDR block whose IP address is the network address, e.g. `2001:db8::/32`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IPv6CIDRNetworkAddress() tfsdk.AttributeValidator {
+	return stringValidator{
+		description: "value must be a valid IPv6 CIDR network address",
+		valid: func(s string) bool {
+			return verify.ValidateIPv6CIDRBlock(s) == nil
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package fwvalidators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwvalidators"
+)
+
+func TestIPv4CIDRNetworkAddressValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a String": {
+			val:         types.Bool{Value: true},
+			expectError: true,
+		},
+		"unknown String": {
+			val: types.String{Unknown: true},
+		},
+		"null String": {
+			val: types.String{Null: true},
+		},
+		"null CIDRBlock": {
+			val: fwtypes.CIDRBlock{Null: true},
+		},
+		"valid String": {
+			val: types.String{Value: "10.0.0.0/16"},
+		},
+		"valid CIDRBlock": {
+			val: fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+		},
+		"host address": {
+			val:         types.String{Value: "10.0.0.1/16"},
+			expectError: true,
+		},
+		"IPv6": {
+			val:         fwtypes.CIDRBlock{Value: "2001:db8::/32"},
+			expectError: true,
+		},
+		"invalid": {
+			val:         types.String{Value: "test-value"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         test.val,
+			}
+			response := tfsdk.ValidateAttributeResponse{}
+			fwvalidators.IPv4CIDRNetworkAddress().Validate(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIPv6CIDRNetworkAddressValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a String": {
+			val:         types.Bool{Value: true},
+			expectError: true,
+		},
+		"unknown String": {
+			val: types.String{Unknown: true},
+		},
+		"null String": {
+			val: types.String{Null: true},
+		},
+		"null CIDRBlock": {
+			val: fwtypes.CIDRBlock{Null: true},
+		},
+		"valid String": {
+			val: types.String{Value: "2001:db8::/32"},
+		},
+		"valid CIDRBlock": {
+			val: fwtypes.CIDRBlock{Value: "2001:db8::/32"},
+		},
+		"host address": {
+			val:         types.String{Value: "2001:db8::1/32"},
+			expectError: true,
+		},
+		"IPv4": {
+			val:         fwtypes.CIDRBlock{Value: "10.0.0.0/16"},
+			expectError: true,
+		},
+		"invalid": {
+			val:         types.String{Value: "test-value"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         test.val,
+			}
+			response := tfsdk.ValidateAttributeResponse{}
+			fwvalidators.IPv6CIDRNetworkAddress().Validate(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package fwvalidators
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// IAMPolicyJSON returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, or a fwtypes.IAMPolicy.
+//   - Is a JSON object, as required for IAM policy documents.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func IAMPolicyJSON() tfsdk.AttributeValidator {
+	return stringValidator{
+		description: "value must be a valid JSON policy document",
+		valid: func(s string) bool {
+			_, errs := verify.ValidIAMPolicyJSON(s, "")
+			return len(errs) == 0
+		},
+	}
+}
+
+// JSON returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, or a fwtypes.JSON.
+//   - Is valid JSON.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func JSON() tfsdk.AttributeValidator {
+	return stringValidator{
+		description: "value must be valid JSON",
+		valid: func(s string) bool {
+			return json.Valid([]byte(s))
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package fwvalidators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwvalidators"
+)
+
+func TestIAMPolicyJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a String": {
+			val:         types.Bool{Value: true},
+			expectError: true,
+		},
+		"unknown String": {
+			val: types.String{Unknown: true},
+		},
+		"null String": {
+			val: types.String{Null: true},
+		},
+		"null IAMPolicy": {
+			val: fwtypes.IAMPolicy{Null: true},
+		},
+		"valid String": {
+			val: types.String{Value: `{"Version":"2012-10-17","Statement":[]}`},
+		},
+		"valid IAMPolicy": {
+			val: fwtypes.IAMPolicy{Value: `{"Version":"2012-10-17","Statement":[]}`},
+		},
+		"JSON array": {
+			val:         types.String{Value: "[]"},
+			expectError: true,
+		},
+		"empty": {
+			val:         types.String{Value: ""},
+			expectError: true,
+		},
+		"invalid": {
+			val:         fwtypes.IAMPolicy{Value: "test-value"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         test.val,
+			}
+			response := tfsdk.ValidateAttributeResponse{}
+			fwvalidators.IAMPolicyJSON().Validate(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a String": {
+			val:         types.Bool{Value: true},
+			expectError: true,
+		},
+		"unknown String": {
+			val: types.String{Unknown: true},
+		},
+		"null String": {
+			val: types.String{Null: true},
+		},
+		"null JSON": {
+			val: fwtypes.JSON{Null: true},
+		},
+		"valid String": {
+			val: types.String{Value: `{"a": [1, 2]}`},
+		},
+		"valid JSON": {
+			val: fwtypes.JSON{Value: "[]"},
+		},
+		"invalid": {
+			val:         fwtypes.JSON{Value: "test-value"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         test.val,
+			}
+			response := tfsdk.ValidateAttributeResponse{}
+			fwvalidators.JSON().Validate(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package fwvalidators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.AttributeValidator = stringValidator{}
+
+// stringValidator validates that a string Attribute's value satisfies a predicate.
+// Values of provider-defined types based on String, such as fwtypes.CIDRBlock, are also validated.
+type stringValidator struct {
+	description string
+	valid       func(string) bool
+}
+
+// Description describes the validation in plain text formatting.
+func (validator stringValidator) Description(_ context.Context) string {
+	return validator.description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator stringValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator stringValidator) Validate(ctx context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, request, response)
+
+	if !ok {
+		return
+	}
+
+	if !validator.valid(s) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.AttributePath,
+			validator.Description(ctx),
+			s,
+		))
+
+		return
+	}
+}
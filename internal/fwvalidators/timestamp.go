@@ -0,0 +1,24 @@
+package fwvalidators
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// RFC3339Timestamp returns an AttributeValidator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, or a fwtypes.Timestamp.
+//   - Is an RFC3339 timestamp, e.g. `2006-01-02T15:04:05Z`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func RFC3339Timestamp() tfsdk.AttributeValidator {
+	return stringValidator{
+		description: "value must be a valid RFC3339 timestamp",
+		valid: func(s string) bool {
+			_, err := time.Parse(time.RFC3339, s)
+			return err == nil
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package fwvalidators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/fwvalidators"
+)
+
+func TestRFC3339TimestampValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         attr.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"not a String": {
+			val:         types.Bool{Value: true},
+			expectError: true,
+		},
+		"unknown String": {
+			val: types.String{Unknown: true},
+		},
+		"null String": {
+			val: types.String{Null: true},
+		},
+		"null Timestamp": {
+			val: fwtypes.Timestamp{Null: true},
+		},
+		"valid String": {
+			val: types.String{Value: "2006-01-02T15:04:05Z"},
+		},
+		"valid Timestamp": {
+			val: fwtypes.Timestamp{Value: "2006-01-02T15:04:05+07:00"},
+		},
+		"date": {
+			val:         types.String{Value: "2006-01-02"},
+			expectError: true,
+		},
+		"invalid": {
+			val:         fwtypes.Timestamp{Value: "test-value"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			request := tfsdk.ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         test.val,
+			}
+			response := tfsdk.ValidateAttributeResponse{}
+			fwvalidators.RFC3339Timestamp().Validate(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // validateInt64String ensures that the request contains a String value which represents a 64-bit integer.
@@ -31,23 +31,40 @@ func validateInt64String(ctx context.Context, request tfsdk.ValidateAttributeReq
 	return i, true
 }
 
-// validateString ensures that the request contains a String value.
+// validateString ensures that the request contains a String value, or a value of a provider-defined type based on String.
 func validateString(ctx context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) (string, bool) {
-	t := request.AttributeConfig.Type(ctx)
-	if t != types.StringType {
+	v, err := request.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.AttributePath,
+			"Invalid Attribute Value",
+			err.Error()))
+		return "", false
+	}
+
+	if !v.Type().Is(tftypes.String) {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
 			request.AttributePath,
 			"expected value of type string",
-			t.String(),
+			request.AttributeConfig.Type(ctx).String(),
 		))
 		return "", false
 	}
 
-	s := request.AttributeConfig.(types.String)
+	if !v.IsKnown() || v.IsNull() {
+		return "", false
+	}
+
+	var s string
 
-	if s.IsUnknown() || s.IsNull() {
+	if err := v.As(&s); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.AttributePath,
+			"Invalid Attribute Value",
+			err.Error()))
 		return "", false
 	}
 
-	return s.Value, true
+	return s, true
 }
@@ -10,12 +10,13 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
+	"go/types"
 	"log"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/tools/go/packages"
@@ -29,6 +30,11 @@ var (
 	listOps   = flag.String("ListOps", "", "ListOps")
 	paginator = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export    = flag.Bool("Export", false, "whether to export the list functions")
+
+	sdkV2           = flag.Bool("v2", false, "whether to generate AWS SDK for Go v2 paginators")
+	inputPaginator  = flag.String("InputPaginator", "", "path to the input pagination token field, defaults to -Paginator (v2 only)")
+	outputPaginator = flag.String("OutputPaginator", "", "path to the output pagination token field, defaults to -Paginator (v2 only)")
+	pageSize        = flag.String("PageSize", "", "path to the input page size field, e.g. MaxResults (v2 only)")
 )
 
 func usage() {
@@ -56,6 +62,15 @@ func main() {
 		filename = args[0]
 	}
 
+	if *sdkV2 {
+		generateV2(filename)
+		return
+	}
+
+	if *inputPaginator != "" || *outputPaginator != "" || *pageSize != "" || strings.Contains(*paginator, ".") {
+		log.Fatalf("-InputPaginator, -OutputPaginator, -PageSize and nested -Paginator paths require -v2")
+	}
+
 	wd, err := os.Getwd()
 
 	if err != nil {
@@ -255,6 +270,470 @@ var headerTemplate string
 //go:embed function.tmpl
 var functionTemplate string
 
+//go:embed v2header.tmpl
+var v2HeaderTemplate string
+
+//go:embed v2paginator.tmpl
+var v2PaginatorTemplate string
+
+//go:embed v2finder.tmpl
+var v2FinderTemplate string
+
+// fieldParent is an intermediate struct pointer on the path to a field.
+type fieldParent struct {
+	Path string // e.g. "Pagination"
+	Type string // e.g. "awstypes.Pagination"
+}
+
+// fieldPath describes a (possibly nested) field in an AWS SDK for Go v2 input or output structure.
+type fieldPath struct {
+	Path    string        // e.g. "Pagination.NextToken"
+	Parents []fieldParent // Outermost first
+	Pointer bool          // Whether the field itself is a pointer
+	Type    string        // The field's type, without any pointer
+}
+
+// Guard returns an expression testing that all of the field's parents in v are non-nil, or "" if there are none.
+func (f fieldPath) Guard(v string) string {
+	guards := make([]string, len(f.Parents))
+
+	for i, parent := range f.Parents {
+		guards[i] = fmt.Sprintf("%s.%s != nil", v, parent.Path)
+	}
+
+	return strings.Join(guards, " && ")
+}
+
+type V2FuncSpec struct {
+	AWSName              string
+	ServicePackage       string
+	ClientType           string
+	ParamType            string
+	ResultType           string
+	GeneratePaginator    bool
+	PaginatorType        string
+	PaginatorOptionsType string
+	NewPaginator         string
+	InputToken           fieldPath
+	OutputToken          fieldPath
+	PageSize             *fieldPath
+	FinderName           string
+	ItemsField           string
+	ItemType             string
+	ItemIsPointer        bool
+}
+
+type V2HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	Imports            []v2Import
+}
+
+type v2Import struct {
+	Alias  string
+	Path   string
+	StdLib bool
+}
+
+// generateV2 generates AWS SDK for Go v2 style paginators, for operations whose SDK package does not define them, and
+// single-result filter-and-find helpers.
+func generateV2(filename string) {
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	awsService, err := names.AWSGoV2Package(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	awsUpper, err := names.AWSGoV1ClientTypeName(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsService)
+	typesPackage := fmt.Sprintf("%s/types", sourcePackage)
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage, typesPackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 2 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	g := v2Generator{
+		imports: map[string]string{
+			"context":     "",
+			sourcePackage: "",
+		},
+		seen: make(map[string]string),
+	}
+
+	for _, pkg := range pkgs {
+		switch pkg.PkgPath {
+		case sourcePackage:
+			g.pkg = newV2Package(pkg)
+		case typesPackage:
+			g.typesPkg = newV2Package(pkg)
+		}
+	}
+
+	functions := strings.Split(*listOps, ",")
+	sort.Strings(functions)
+
+	inputToken, outputToken := *paginator, *paginator
+	if *inputPaginator != "" {
+		inputToken = *inputPaginator
+	}
+	if *outputPaginator != "" {
+		outputToken = *outputPaginator
+	}
+
+	var body bytes.Buffer
+	paginatorTmpl := template.Must(template.New("paginator").Parse(v2PaginatorTemplate))
+	finderTmpl := template.Must(template.New("finder").Parse(v2FinderTemplate))
+
+	for _, functionName := range functions {
+		funcSpec := g.funcSpec(functionName, fixUpFuncName(functionName, awsUpper), inputToken, outputToken, *pageSize, *export)
+
+		if funcSpec.GeneratePaginator {
+			if err := paginatorTmpl.Execute(&body, funcSpec); err != nil {
+				log.Fatalf("error writing paginator \"%s\": %s", functionName, err)
+			}
+		}
+
+		if funcSpec.FinderName != "" {
+			if err := finderTmpl.Execute(&body, funcSpec); err != nil {
+				log.Fatalf("error writing finder \"%s\": %s", functionName, err)
+			}
+		}
+	}
+
+	imports := make([]v2Import, 0, len(g.imports))
+	for path, alias := range g.imports {
+		// Types can be resolved without being referenced in generated code.
+		name := alias
+		if name == "" {
+			name = path[strings.LastIndex(path, "/")+1:]
+		}
+		if !bytes.Contains(body.Bytes(), []byte(name+".")) {
+			continue
+		}
+
+		imports = append(imports, v2Import{
+			Alias:  alias,
+			Path:   path,
+			StdLib: !strings.Contains(path, "."),
+		})
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		return imports[i].Path < imports[j].Path
+	})
+
+	gen := Generator{}
+	header := template.Must(template.New("header").Parse(v2HeaderTemplate))
+	err = header.Execute(&gen.buf, V2HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		Imports:            imports,
+	})
+	if err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+	gen.buf.Write(body.Bytes())
+
+	src := gen.format()
+
+	err = os.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// v2Package is a parsed AWS SDK for Go v2 package.
+type v2Package struct {
+	name    string
+	path    string
+	structs map[string]*ast.StructType
+	funcs   map[string]*ast.FuncDecl // Client methods and package-level functions
+}
+
+func newV2Package(pkg *packages.Package) *v2Package {
+	v := &v2Package{
+		name:    pkg.Name,
+		path:    pkg.PkgPath,
+		structs: make(map[string]*ast.StructType),
+		funcs:   make(map[string]*ast.FuncDecl),
+	}
+
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					v.funcs[decl.Name.Name] = decl
+				} else if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok && ident.Name == "Client" {
+						v.funcs["Client."+decl.Name.Name] = decl
+					}
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := spec.Type.(*ast.StructType); ok {
+							v.structs[spec.Name.Name] = st
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return v
+}
+
+type v2Generator struct {
+	imports  map[string]string // Import path to alias
+	pkg      *v2Package
+	typesPkg *v2Package
+	seen     map[string]string // Generated identifier to operation
+}
+
+// v2Type is a type referenced from an AWS SDK for Go v2 package.
+type v2Type struct {
+	pkg  *v2Package // nil for predeclared types
+	expr ast.Expr
+}
+
+func (g *v2Generator) funcSpec(functionName, baseName, inputToken, outputToken, pageSize string, export bool) V2FuncSpec {
+	method, ok := g.pkg.funcs["Client."+functionName]
+	if !ok {
+		log.Fatalf("function \"%s\" not found", functionName)
+	}
+
+	// func (c *Client) Op(ctx context.Context, params *OpInput, optFns ...func(*Options)) (*OpOutput, error)
+	params, results := method.Type.Params.List, method.Type.Results.List
+	if len(params) != 3 || len(results) != 2 {
+		log.Fatalf("function \"%s\" has an unexpected signature", functionName)
+	}
+	paramType := v2Type{pkg: g.pkg, expr: params[1].Type}
+	resultType := v2Type{pkg: g.pkg, expr: results[0].Type}
+
+	funcSpec := V2FuncSpec{
+		AWSName:        functionName,
+		ServicePackage: g.pkg.name,
+		ClientType:     fmt.Sprintf("*%s.Client", g.pkg.name),
+		ParamType:      g.typeString(paramType),
+		ResultType:     g.typeString(resultType),
+		InputToken:     g.fieldPath(paramType, inputToken),
+		OutputToken:    g.fieldPath(resultType, outputToken),
+	}
+
+	if funcSpec.InputToken.Type != "string" || !funcSpec.InputToken.Pointer {
+		log.Fatalf("function \"%s\": input pagination token \"%s\" is not a *string", functionName, inputToken)
+	}
+	if funcSpec.OutputToken.Type != "string" || !funcSpec.OutputToken.Pointer {
+		log.Fatalf("function \"%s\": output pagination token \"%s\" is not a *string", functionName, outputToken)
+	}
+
+	if pageSize != "" {
+		v := g.fieldPath(paramType, pageSize)
+		switch v.Type {
+		case "int32", "int64":
+		default:
+			log.Fatalf("function \"%s\": page size \"%s\" is not an integer", functionName, pageSize)
+		}
+		funcSpec.PageSize = &v
+	}
+
+	if export {
+		funcSpec.PaginatorType = fmt.Sprintf("%sPaginator", baseName)
+		funcSpec.NewPaginator = fmt.Sprintf("New%sPaginator", baseName)
+	} else {
+		funcSpec.PaginatorType = fmt.Sprintf("%s%sPaginator", strings.ToLower(baseName[0:1]), baseName[1:])
+		funcSpec.NewPaginator = fmt.Sprintf("new%sPaginator", baseName)
+	}
+	funcSpec.PaginatorOptionsType = fmt.Sprintf("%sOptions", funcSpec.PaginatorType)
+
+	if newPaginator := fmt.Sprintf("New%sPaginator", functionName); g.pkg.funcs[newPaginator] != nil {
+		log.Printf("%s.%s exists, not generating a paginator for %s", g.pkg.name, newPaginator, functionName)
+		funcSpec.NewPaginator = fmt.Sprintf("%s.%s", g.pkg.name, newPaginator)
+	} else {
+		funcSpec.GeneratePaginator = true
+		g.imports["fmt"] = ""
+		g.addIdentifier(funcSpec.PaginatorType, functionName)
+		g.addIdentifier(funcSpec.PaginatorOptionsType, functionName)
+		g.addIdentifier(funcSpec.NewPaginator, functionName)
+	}
+
+	// The filter-and-find helper is generated for operations returning a single collection.
+	var items []*ast.Field
+	for _, field := range g.structType(resultType).Fields.List {
+		if _, ok := field.Type.(*ast.ArrayType); ok && len(field.Names) == 1 && field.Names[0].IsExported() {
+			items = append(items, field)
+		}
+	}
+
+	if len(items) != 1 {
+		log.Printf("%s does not return a single collection, not generating a finder", functionName)
+		return funcSpec
+	}
+
+	itemType := v2Type{pkg: resultType.pkg, expr: items[0].Type.(*ast.ArrayType).Elt}
+	if star, ok := itemType.expr.(*ast.StarExpr); ok {
+		funcSpec.ItemIsPointer = true
+		itemType.expr = star.X
+	}
+
+	funcSpec.ItemsField = items[0].Names[0].Name
+	funcSpec.ItemType = g.typeString(itemType)
+
+	itemName := funcSpec.ItemType[strings.LastIndex(funcSpec.ItemType, ".")+1:]
+	if !strings.Contains(funcSpec.ItemType, ".") {
+		itemName = strings.TrimSuffix(baseName, "s")
+	}
+	if export {
+		funcSpec.FinderName = fmt.Sprintf("Find%s", itemName)
+	} else {
+		funcSpec.FinderName = fmt.Sprintf("find%s", itemName)
+	}
+	g.addIdentifier(funcSpec.FinderName, functionName)
+	g.imports["github.com/hashicorp/terraform-provider-aws/internal/tfresource"] = ""
+
+	return funcSpec
+}
+
+// addIdentifier records a generated identifier, failing if another operation has already generated it.
+func (g *v2Generator) addIdentifier(name, functionName string) {
+	if v, ok := g.seen[name]; ok {
+		log.Fatalf("function \"%s\": %s is already generated for \"%s\"", functionName, name, v)
+	}
+
+	g.seen[name] = functionName
+}
+
+// fieldPath resolves a dot-separated path to a field in the structure referenced by typ.
+func (g *v2Generator) fieldPath(typ v2Type, path string) fieldPath {
+	v := fieldPath{
+		Path: path,
+	}
+	segments := strings.Split(path, ".")
+
+	for i, segment := range segments {
+		var field *ast.Field
+		for _, f := range g.structType(typ).Fields.List {
+			if len(f.Names) == 1 && f.Names[0].Name == segment {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			log.Fatalf("field \"%s\" not found in %s", strings.Join(segments[:i+1], "."), g.typeString(typ))
+		}
+
+		typ = g.resolve(typ.pkg, field.Type)
+		star, isPointer := typ.expr.(*ast.StarExpr)
+		if isPointer {
+			typ.expr = star.X
+		}
+
+		if i == len(segments)-1 {
+			v.Pointer = isPointer
+			v.Type = g.typeString(typ)
+			break
+		}
+
+		if isPointer {
+			v.Parents = append(v.Parents, fieldParent{
+				Path: strings.Join(segments[:i+1], "."),
+				Type: g.typeString(typ),
+			})
+		}
+	}
+
+	return v
+}
+
+// resolve returns the type referenced by expr in pkg.
+func (g *v2Generator) resolve(pkg *v2Package, expr ast.Expr) v2Type {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		t := g.resolve(pkg, v.X)
+		t.expr = &ast.StarExpr{X: t.expr}
+		return t
+	case *ast.Ident:
+		if pkg == nil {
+			return v2Type{expr: v}
+		}
+		if _, ok := pkg.structs[v.Name]; !ok && types.Universe.Lookup(v.Name) != nil {
+			return v2Type{expr: v}
+		}
+		return v2Type{pkg: pkg, expr: v}
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "types" && g.typesPkg != nil {
+			return v2Type{pkg: g.typesPkg, expr: v.Sel}
+		}
+	}
+
+	log.Fatalf("Unexpected expression: (%[1]T) %[1]v", expr)
+	return v2Type{}
+}
+
+// structType returns the structure referenced by typ.
+func (g *v2Generator) structType(typ v2Type) *ast.StructType {
+	expr := typ.expr
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	typ = g.resolve(typ.pkg, expr)
+	if ident, ok := typ.expr.(*ast.Ident); ok && typ.pkg != nil {
+		if v, ok := typ.pkg.structs[ident.Name]; ok {
+			return v
+		}
+	}
+
+	log.Fatalf("%s is not a structure", g.typeString(typ))
+	return nil
+}
+
+// typeString returns typ's Go source representation, recording any packages that need to be imported.
+func (g *v2Generator) typeString(typ v2Type) string {
+	typ = g.resolve(typ.pkg, typ.expr)
+
+	var prefix string
+	expr := typ.expr
+	for {
+		star, ok := expr.(*ast.StarExpr)
+		if !ok {
+			break
+		}
+		prefix += "*"
+		expr = star.X
+	}
+
+	ident := expr.(*ast.Ident)
+
+	switch typ.pkg {
+	case nil:
+		return prefix + ident.Name
+	case g.typesPkg:
+		g.imports[typ.pkg.path] = "awstypes"
+		return fmt.Sprintf("%sawstypes.%s", prefix, ident.Name)
+	default:
+		return fmt.Sprintf("%s%s.%s", prefix, typ.pkg.name, ident.Name)
+	}
+}
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
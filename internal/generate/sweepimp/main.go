@@ -134,9 +134,7 @@ package sweep_test
 
 import (
 	"testing"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-{{- range .Services }}
+{{ range .Services }}
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/{{ .ProviderPackage }}"
 {{- end }}
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -144,6 +142,6 @@ import (
 
 func TestMain(m *testing.M) {
 	sweep.SweeperClients = make(map[string]interface{})
-	resource.TestMain(m)
+	sweep.TestMain(m)
 }
 `
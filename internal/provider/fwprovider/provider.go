@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/fwtypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
@@ -82,6 +83,11 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"pgp_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "A PGP public key, base64-encoded, or a keybase username in the form\n`keybase:some_person_that_exists`, used to encrypt secret values written to state\nby resources and data sources that don't set their own `pgp_key`, unless they opt out\nwith `use_provider_pgp_key = false`.",
+			},
 			"profile": {
 				Type:        types.StringType,
 				Optional:    true,
@@ -96,7 +102,7 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Type:               types.BoolType,
 				Optional:           true,
 				Description:        "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
-				DeprecationMessage: "Use s3_use_path_style instead.",
+				DeprecationMessage: deprecation.ProviderMessage("s3_force_path_style"),
 			},
 			"s3_use_path_style": {
 				Type:        types.BoolType,
@@ -117,7 +123,7 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 				Type:               types.StringType,
 				Optional:           true,
 				Description:        "The path to the shared credentials file. If not set, defaults to ~/.aws/credentials.",
-				DeprecationMessage: "Use shared_credentials_files instead.",
+				DeprecationMessage: deprecation.ProviderMessage("shared_credentials_file"),
 			},
 			"shared_credentials_files": {
 				Type:        types.ListType{ElemType: types.StringType},
@@ -182,7 +188,7 @@ func (p *fwprovider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnost
 						Type:               types.Int64Type,
 						Optional:           true,
 						Description:        "The duration, in seconds, of the role session.",
-						DeprecationMessage: "Use assume_role.duration instead",
+						DeprecationMessage: deprecation.ProviderMessage("assume_role.duration_seconds"),
 					},
 					"external_id": {
 						Type:        types.StringType,
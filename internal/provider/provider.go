@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/intf"
 	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -307,6 +309,14 @@ func New(_ context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "A PGP public key, base64-encoded, or a keybase username in the form\n" +
+					"`keybase:some_person_that_exists`, used to encrypt secret values written to state\n" +
+					"by resources and data sources that don't set their own `pgp_key`, unless they opt out\n" +
+					"with `use_provider_pgp_key = false`.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -322,7 +332,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
-				Deprecated: "Use s3_use_path_style instead.",
+				Deprecated: deprecation.ProviderMessage("s3_force_path_style"),
 				Description: "Set this to true to enable the request to use path-style addressing,\n" +
 					"i.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 					"use virtual hosted bucket addressing when possible\n" +
@@ -351,7 +361,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"shared_credentials_file": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				Deprecated:    "Use shared_credentials_files instead.",
+				Deprecated:    deprecation.ProviderMessage("shared_credentials_file"),
 				ConflictsWith: []string{"shared_credentials_files"},
 				Description:   "The path to the shared credentials file. If not set, defaults to ~/.aws/credentials.",
 			},
@@ -606,6 +616,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc_security_group_rule":                    ec2.DataSourceSecurityGroupRule(),
+			"aws_vpc_security_group_rules":                   ec2.DataSourceSecurityGroupRules(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
@@ -674,21 +686,22 @@ func New(_ context.Context) (*schema.Provider, error) {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_instance_profiles":       iam.DataSourceInstanceProfiles(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_instance_profiles":           iam.DataSourceInstanceProfiles(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_saml_provider":               iam.DataSourceSAMLProvider(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -1420,6 +1433,8 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_security_group_egress_rule":                   ec2.ResourceSecurityGroupEgressRule(),
+			"aws_vpc_security_group_ingress_rule":                  ec2.ResourceSecurityGroupIngressRule(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -1966,6 +1981,7 @@ func New(_ context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_objects":                           s3.ResourceDirectoryObjects(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -2288,6 +2304,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("pgp_key"); ok {
+		config.PGPKey = v.(string)
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2298,6 +2318,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.SharedConfigFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
+	if v := os.Getenv(envvar.LocalEndpoint); v != "" {
+		config.LocalEndpoint = v
+	}
+
 	if v, null, _ := nullable.Bool(d.Get("skip_metadata_api_check").(string)).Value(); !null {
 		if v {
 			config.EC2MetadataServiceEnableState = imds.ClientDisabled
@@ -2343,7 +2367,7 @@ func assumeRoleSchema() *schema.Schema {
 				"duration_seconds": {
 					Type:          schema.TypeInt,
 					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
+					Deprecated:    deprecation.ProviderMessage("assume_role.duration_seconds"),
 					Description:   "The duration, in seconds, of the role session.",
 					ValidateFunc:  validation.IntBetween(900, 43200),
 					ConflictsWith: []string{"assume_role.0.duration"},
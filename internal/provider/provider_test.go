@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -182,3 +185,60 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestDeprecationsRegistered(t *testing.T) {
+	p, err := New(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, d := range deprecation.All() {
+		var schemaMap map[string]*schema.Schema
+
+		switch d.Kind {
+		case deprecation.KindProvider:
+			schemaMap = p.Schema
+		case deprecation.KindResource:
+			if r, ok := p.ResourcesMap[d.TypeName]; ok {
+				schemaMap = r.Schema
+			}
+		case deprecation.KindDataSource:
+			if r, ok := p.DataSourcesMap[d.TypeName]; ok {
+				schemaMap = r.Schema
+			}
+		}
+
+		if schemaMap == nil {
+			t.Errorf("%s %s not found", d.Kind, d.TypeName)
+
+			continue
+		}
+
+		var s *schema.Schema
+
+		for _, name := range strings.Split(d.Attribute, ".") {
+			if s != nil {
+				schemaMap = nil
+
+				if elem, ok := s.Elem.(*schema.Resource); ok {
+					schemaMap = elem.Schema
+				}
+			}
+
+			if s = schemaMap[name]; s == nil {
+				break
+			}
+		}
+
+		if s == nil {
+			t.Errorf("%s %s: argument %s not found", d.Kind, d.TypeName, d.Attribute)
+
+			continue
+		}
+
+		if got, expected := s.Deprecated, d.Message(); got != expected {
+			t.Errorf("%s %s: argument %s is deprecated with %q, expected %q", d.Kind, d.TypeName, d.Attribute, got, expected)
+		}
+	}
+}
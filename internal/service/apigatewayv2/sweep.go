@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -25,28 +25,32 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_stage", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_stage", &resource.Sweeper{
 		Name: "aws_apigatewayv2_stage",
 		F:    sweepStages,
 	})
 }
 
 func sweepAPIs(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -108,7 +112,7 @@ func sweepDomainNames(region string) error {
 			r := ResourceDomainName()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(domainName.DomainName))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -133,6 +137,10 @@ func sweepDomainNames(region string) error {
 }
 
 func sweepVPCLinks(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
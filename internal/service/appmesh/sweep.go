@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -32,12 +32,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -45,12 +45,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
@@ -104,7 +104,7 @@ func sweepGatewayRoutes(region string) error {
 							d.Set("mesh_name", meshName)
 							d.Set("name", gatewayRouteName)
 							d.Set("virtual_gateway_name", virtualGatewayName)
-							err := r.Delete(d, client)
+							err := sweep.DeleteResource(r, d, client)
 
 							if err != nil {
 								log.Printf("[ERROR] %s", err)
@@ -143,6 +143,10 @@ func sweepGatewayRoutes(region string) error {
 }
 
 func sweepMeshes(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -183,6 +187,10 @@ func sweepMeshes(region string) error {
 }
 
 func sweepRoutes(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -293,7 +301,7 @@ func sweepVirtualGateways(region string) error {
 					d.SetId("????????????????") // ID not used in Delete.
 					d.Set("mesh_name", meshName)
 					d.Set("name", virtualGatewayName)
-					err := r.Delete(d, client)
+					err := sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -324,6 +332,10 @@ func sweepVirtualGateways(region string) error {
 }
 
 func sweepVirtualNodes(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -388,6 +400,10 @@ func sweepVirtualNodes(region string) error {
 }
 
 func sweepVirtualRouters(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
@@ -447,6 +463,10 @@ func sweepVirtualRouters(region string) error {
 }
 
 func sweepVirtualServices(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
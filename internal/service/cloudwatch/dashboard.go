@@ -1,12 +1,14 @@
 package cloudwatch
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,10 +20,10 @@ import (
 
 func ResourceDashboard() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDashboardPut,
-		Read:   resourceDashboardRead,
-		Update: resourceDashboardPut,
-		Delete: resourceDashboardDelete,
+		Create:               resourceDashboardPut,
+		Read:                 resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		Delete:               resourceDashboardDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -44,7 +46,7 @@ func ResourceDashboard() *schema.Resource {
 					json, _ := structure.NormalizeJsonString(v)
 					return json
 				},
-				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc: verify.DashboardBodyNormalizer.SuppressDiffs,
 			},
 			"dashboard_name": {
 				Type:         schema.TypeString,
@@ -101,6 +103,12 @@ func resourceDashboardPut(d *schema.ResourceData, meta interface{}) error {
 	return resourceDashboardRead(d, meta)
 }
 
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := verify.DashboardBodyNormalizer.ChangeWarnings(d, "dashboard_body")
+
+	return append(diags, diag.FromErr(resourceDashboardPut(d, meta))...)
+}
+
 func resourceDashboardDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Deleting CloudWatch Dashboard %s", d.Id())
 	conn := meta.(*conns.AWSClient).CloudWatchConn
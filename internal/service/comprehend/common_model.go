@@ -2,17 +2,21 @@ package comprehend
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 type safeMutex struct {
@@ -34,6 +38,33 @@ func (m *safeMutex) Unlock() {
 
 var modelVPCENILock safeMutex
 
+// findEndpointByModelARN returns the endpoint that serves, or is being updated to serve, the specified model version.
+func findEndpointByModelARN(ctx context.Context, conn *comprehend.Client, arn string) (*types.EndpointProperties, error) {
+	return findEndpointProperties(ctx, conn, &comprehend.ListEndpointsInput{}, func(v *types.EndpointProperties) bool {
+		return aws.ToString(v.ModelArn) == arn || aws.ToString(v.DesiredModelArn) == arn
+	})
+}
+
+// checkModelVersionNotInUse returns an error if any endpoint serves, or is being updated to serve, the specified model version.
+// Such a model version cannot be deleted.
+func checkModelVersionNotInUse(ctx context.Context, conn *comprehend.Client, arn string) error {
+	endpoint, err := findEndpointByModelARN(ctx, conn, arn)
+
+	if errors.Is(err, tfresource.ErrTooManyResults) {
+		return errors.New("in use by more than one endpoint")
+	}
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("finding endpoints: %w", err)
+	}
+
+	return fmt.Errorf("in use by endpoint (%s)", aws.ToString(endpoint.EndpointArn))
+}
+
 func findNetworkInterfaces(ctx context.Context, conn *ec2.EC2, securityGroups []string, subnets []string) ([]*ec2.NetworkInterface, error) {
 	networkInterfaces, err := tfec2.FindNetworkInterfacesWithContext(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
 		Filters: []*ec2.Filter{
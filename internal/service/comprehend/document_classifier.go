@@ -418,6 +418,10 @@ func resourceDocumentClassifierDelete(ctx context.Context, d *schema.ResourceDat
 	for _, v := range versions {
 		v := v
 		g.Go(func() error {
+			if err := checkModelVersionNotInUse(ctx, conn, aws.ToString(v.DocumentClassifierArn)); err != nil {
+				return fmt.Errorf("deleting version (%s): %w", aws.ToString(v.VersionName), err)
+			}
+
 			_, err = conn.DeleteDocumentClassifier(ctx, &comprehend.DeleteDocumentClassifierInput{
 				DocumentClassifierArn: v.DocumentClassifierArn,
 			})
@@ -448,6 +448,10 @@ func resourceEntityRecognizerDelete(ctx context.Context, d *schema.ResourceData,
 	for _, v := range versions {
 		v := v
 		g.Go(func() error {
+			if err := checkModelVersionNotInUse(ctx, conn, aws.ToString(v.EntityRecognizerArn)); err != nil {
+				return fmt.Errorf("deleting version (%s): %w", aws.ToString(v.VersionName), err)
+			}
+
 			_, err = conn.DeleteEntityRecognizer(ctx, &comprehend.DeleteEntityRecognizerInput{
 				EntityRecognizerArn: v.EntityRecognizerArn,
 			})
@@ -1,3 +1,4 @@
+//go:generate go run -tags generate ../../generate/listpages/main.go -v2 -ListOps=ListEndpoints -PageSize=MaxResults
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -ListTags -UpdateTags -AWSSDKVersion=2
 //go:generate go run ./test-fixtures/generate/document_classifier/main.go
 //go:generate go run ./test-fixtures/generate/entity_recognizer/main.go
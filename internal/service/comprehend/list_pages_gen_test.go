@@ -0,0 +1,189 @@
+package comprehend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// listEndpointsClient serves ListEndpoints pages of two endpoints each, recording the MaxResults of each request.
+// Endpoints in models serve the corresponding model.
+type listEndpointsClient struct {
+	endpoints  []string
+	models     map[string]string
+	maxResults []int32
+}
+
+func (c *listEndpointsClient) Do(r *http.Request) (*http.Response, error) {
+	var input struct {
+		MaxResults int32
+		NextToken  string
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		return nil, err
+	}
+	c.maxResults = append(c.maxResults, input.MaxResults)
+
+	var start int
+	if input.NextToken != "" {
+		if _, err := fmt.Sscan(input.NextToken, &start); err != nil {
+			return nil, err
+		}
+	}
+
+	var output struct {
+		EndpointPropertiesList []map[string]string
+		NextToken              string `json:",omitempty"`
+	}
+
+	end := start + 2
+	if end >= len(c.endpoints) {
+		end = len(c.endpoints)
+	} else {
+		output.NextToken = fmt.Sprint(end)
+	}
+	for _, v := range c.endpoints[start:end] {
+		endpoint := map[string]string{"EndpointArn": v}
+		if model, ok := c.models[v]; ok {
+			endpoint["ModelArn"] = model
+		}
+		output.EndpointPropertiesList = append(output.EndpointPropertiesList, endpoint)
+	}
+
+	body, err := json.Marshal(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		Body:       io.NopCloser(strings.NewReader(string(body))),
+	}, nil
+}
+
+func newListEndpointsClient(endpoints ...string) (*comprehend.Client, *listEndpointsClient) {
+	httpClient := &listEndpointsClient{
+		endpoints: endpoints,
+	}
+
+	return comprehend.New(comprehend.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		}),
+		HTTPClient: httpClient,
+	}), httpClient
+}
+
+func TestListEndpointsPaginator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, httpClient := newListEndpointsClient("a", "b", "c", "d", "e")
+
+	pages := newListEndpointsPaginator(conn, &comprehend.ListEndpointsInput{
+		MaxResults: aws.Int32(2),
+	})
+
+	var got []string
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for _, v := range page.EndpointPropertiesList {
+			got = append(got, aws.ToString(v.EndpointArn))
+		}
+	}
+
+	if got, want := strings.Join(got, ","), "a,b,c,d,e"; got != want {
+		t.Errorf("got endpoints %q, want %q", got, want)
+	}
+
+	if got, want := len(httpClient.maxResults), 3; got != want {
+		t.Fatalf("got %d requests, want %d", got, want)
+	}
+	for i, v := range httpClient.maxResults {
+		if v != 2 {
+			t.Errorf("request %d: got MaxResults %d, want 2", i, v)
+		}
+	}
+
+	if _, err := pages.NextPage(ctx); err == nil {
+		t.Error("expected error reading past the last page")
+	}
+}
+
+func TestFindEndpointProperties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, _ := newListEndpointsClient("a", "b", "c", "b")
+
+	v, err := findEndpointProperties(ctx, conn, &comprehend.ListEndpointsInput{}, func(v *types.EndpointProperties) bool {
+		return aws.ToString(v.EndpointArn) == "c"
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(v.EndpointArn), "c"; got != want {
+		t.Errorf("got endpoint %q, want %q", got, want)
+	}
+
+	_, err = findEndpointProperties(ctx, conn, &comprehend.ListEndpointsInput{}, func(v *types.EndpointProperties) bool {
+		return aws.ToString(v.EndpointArn) == "z"
+	})
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("got error %v, want not found", err)
+	}
+
+	_, err = findEndpointProperties(ctx, conn, &comprehend.ListEndpointsInput{}, func(v *types.EndpointProperties) bool {
+		return aws.ToString(v.EndpointArn) == "b"
+	})
+
+	var tooMany *tfresource.TooManyResultsError
+	if !errors.As(err, &tooMany) || tooMany.Count != 2 {
+		t.Errorf("got error %v, want too many results", err)
+	}
+}
+
+func TestCheckModelVersionNotInUse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, httpClient := newListEndpointsClient("a", "b", "c")
+	httpClient.models = map[string]string{
+		"a": "model-1",
+		"b": "model-2",
+		"c": "model-2",
+	}
+
+	if err := checkModelVersionNotInUse(ctx, conn, "model-0"); err != nil {
+		t.Errorf("unused model: unexpected error: %s", err)
+	}
+
+	if err := checkModelVersionNotInUse(ctx, conn, "model-1"); err == nil || !strings.Contains(err.Error(), "endpoint (a)") {
+		t.Errorf("model used by one endpoint: got error %v, want in use by endpoint (a)", err)
+	}
+
+	if err := checkModelVersionNotInUse(ctx, conn, "model-2"); err == nil || !strings.Contains(err.Error(), "more than one endpoint") {
+		t.Errorf("model used by two endpoints: got error %v, want in use by more than one endpoint", err)
+	}
+}
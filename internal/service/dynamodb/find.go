@@ -59,6 +59,31 @@ func findTableByName(conn *dynamodb.DynamoDB, tableName string) (*dynamodb.Table
 	return output.Table, nil
 }
 
+func findImportByARN(conn *dynamodb.DynamoDB, importARN string) (*dynamodb.ImportTableDescription, error) {
+	input := &dynamodb.DescribeImportInput{
+		ImportArn: aws.String(importARN),
+	}
+
+	output, err := conn.DescribeImport(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeImportNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportTableDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImportTableDescription, nil
+}
+
 func findGSIByTableNameIndexName(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.GlobalSecondaryIndexDescription, error) {
 	table, err := findTableByName(conn, tableName)
 
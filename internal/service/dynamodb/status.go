@@ -46,6 +46,22 @@ func statusTable(conn *dynamodb.DynamoDB, tableName string) resource.StateRefres
 	}
 }
 
+func statusImport(conn *dynamodb.DynamoDB, importARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImportByARN(conn, importARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ImportStatus), nil
+	}
+}
+
 func statusReplicaUpdate(conn *dynamodb.DynamoDB, tableName, region string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		result, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
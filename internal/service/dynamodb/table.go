@@ -93,6 +93,16 @@ func ResourceTable() *schema.Resource {
 				// https://github.com/hashicorp/terraform-provider-aws/issues/25214
 				return old.(string) != new.(string) && new.(string) != ""
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				// The import only happens when the table is created, so adding an import_table block
+				// to an existing table, e.g. one imported into state, doesn't replace it.
+				if diff.Id() != "" && diff.HasChange("import_table") {
+					if o, _ := diff.GetChange("import_table"); len(o.([]interface{})) == 0 {
+						return diff.Clear("import_table")
+					}
+				}
+				return nil
+			},
 			verify.SetTagsDiff,
 		),
 
@@ -182,6 +192,109 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_secondary_index", "restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"error_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imported_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"processed_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"processed_size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -477,6 +590,100 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		if output == nil || output.TableDescription == nil {
 			return errors.New("error creating DynamoDB Table: empty response")
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input := expandImportTable(tfMap)
+		// The same client token is used across retries so that only one import is started.
+		input.ClientToken = aws.String(resource.UniqueId())
+
+		billingMode := d.Get("billing_mode").(string)
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		input.TableCreationParameters = &dynamodb.TableCreationParameters{
+			AttributeDefinitions:  expandAttributes(d.Get("attribute").(*schema.Set).List()),
+			BillingMode:           aws.String(billingMode),
+			KeySchema:             expandKeySchema(keySchemaMap),
+			ProvisionedThroughput: expandProvisionedThroughput(capacityMap, billingMode),
+			TableName:             aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			input.TableCreationParameters.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			input.TableCreationParameters.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		var output *dynamodb.ImportTableOutput
+		err := resource.Retry(createTableTimeout, func() *resource.RetryError {
+			var err error
+			output, err = conn.ImportTable(input)
+			if err != nil {
+				if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+					return resource.RetryableError(err)
+				}
+				if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+					return resource.RetryableError(err)
+				}
+
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			output, err = conn.ImportTable(input)
+		}
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Get("name").(string), err)
+		}
+
+		if output == nil || output.ImportTableDescription == nil {
+			return errors.New("error creating DynamoDB Table: empty response")
+		}
+
+		// The import can take hours. If waiting fails, the table exists so the resource must be tainted.
+		d.SetId(d.Get("name").(string))
+
+		importARN := aws.StringValue(output.ImportTableDescription.ImportArn)
+		importOutput, err := waitImportComplete(conn, importARN, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Get("name").(string), fmt.Errorf("import (%s): %w", importARN, err))
+		}
+
+		log.Printf("[INFO] DynamoDB Table (%s) import (%s) complete: %d of %d items imported, %d errors", d.Get("name").(string), importARN,
+			aws.Int64Value(importOutput.ImportedItemCount), aws.Int64Value(importOutput.ProcessedItemCount), aws.Int64Value(importOutput.ErrorCount))
+
+		// The import statistics are only known when the table is created, so they're kept in state alongside the configured source.
+		tfMap["error_count"] = aws.Int64Value(importOutput.ErrorCount)
+		tfMap["import_arn"] = importARN
+		tfMap["import_status"] = aws.StringValue(importOutput.ImportStatus)
+		tfMap["imported_item_count"] = aws.Int64Value(importOutput.ImportedItemCount)
+		tfMap["processed_item_count"] = aws.Int64Value(importOutput.ProcessedItemCount)
+		tfMap["processed_size_bytes"] = aws.Int64Value(importOutput.ProcessedSizeBytes)
+
+		if err := d.Set("import_table", []interface{}{tfMap}); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionSetting, ResNameTable, d.Get("name").(string), err)
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			TableName:   aws.String(d.Get("name").(string)),
@@ -579,6 +786,12 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 		return create.Error(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
 	}
 
+	if _, ok := d.GetOk("import_table.0.import_arn"); ok {
+		if err := updateImportedTable(conn, d, aws.StringValue(output.TableArn), tags); err != nil {
+			return create.Error(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("global_secondary_index"); ok {
 		gsiSet := v.(*schema.Set)
 
@@ -1006,6 +1219,46 @@ func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
 
 // custom diff
 
+// updateImportedTable sets the table settings ImportTable doesn't support once the import is complete.
+func updateImportedTable(conn *dynamodb.DynamoDB, d *schema.ResourceData, tableARN string, tags tftags.KeyValueTags) error {
+	hasTableUpdate := false
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(d.Id()),
+	}
+
+	if d.Get("stream_enabled").(bool) {
+		hasTableUpdate = true
+		input.StreamSpecification = &dynamodb.StreamSpecification{
+			StreamEnabled:  aws.Bool(true),
+			StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("table_class"); ok && v.(string) != dynamodb.TableClassStandard {
+		hasTableUpdate = true
+		input.TableClass = aws.String(v.(string))
+	}
+
+	if hasTableUpdate {
+		log.Printf("[DEBUG] Updating imported DynamoDB Table: %s", input)
+		if _, err := conn.UpdateTable(input); err != nil {
+			return fmt.Errorf("updating imported table: %w", err)
+		}
+
+		if _, err := waitTableActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("waiting for imported table update: %w", err)
+		}
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, tableARN, nil, tags.IgnoreAWS()); err != nil {
+			return fmt.Errorf("tagging imported table: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func isTableOptionDisabled(v interface{}) bool {
 	options := v.([]interface{})
 	if len(options) == 0 {
@@ -1710,6 +1963,61 @@ func flattenTableServerSideEncryption(description *dynamodb.SSEDescription) []in
 	return []interface{}{m}
 }
 
+func expandImportTable(tfMap map[string]interface{}) *dynamodb.ImportTableInput {
+	apiObject := &dynamodb.ImportTableInput{
+		InputFormat: aws.String(tfMap["input_format"].(string)),
+	}
+
+	if v, ok := tfMap["input_compression_type"].(string); ok && v != "" {
+		apiObject.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandInputFormatOptions(tfMap map[string]interface{}) *dynamodb.InputFormatOptions {
+	apiObject := &dynamodb.InputFormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Csv = &dynamodb.CsvOptions{}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			apiObject.Csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := tfMap["header_list"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Csv.HeaderList = flex.ExpandStringList(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3BucketSource(tfMap map[string]interface{}) *dynamodb.S3BucketSource {
+	apiObject := &dynamodb.S3BucketSource{
+		S3Bucket: aws.String(tfMap["bucket"].(string)),
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandAttributes(cfg []interface{}) []*dynamodb.AttributeDefinition {
 	attributes := make([]*dynamodb.AttributeDefinition, len(cfg))
 	for i, attribute := range cfg {
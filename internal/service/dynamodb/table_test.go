@@ -357,6 +357,46 @@ func TestAccDynamoDBTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	var conf dynamodb.DescribeTableOutput
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importTable(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.error_count", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "import_table.0.import_arn", "dynamodb", regexp.MustCompile(fmt.Sprintf(`table/%s/import/.+$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.import_status", dynamodb.ImportStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.imported_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", dynamodb.InputFormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.delimiter", ";"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.processed_item_count", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "import_table.0.s3_bucket_source.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "stream_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_table"},
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_disappears(t *testing.T) {
 	var table1 dynamodb.DescribeTableOutput
 	resourceName := "aws_dynamodb_table.test"
@@ -2212,6 +2252,59 @@ func testAccCheckInitialTableConf(resourceName string) resource.TestCheckFunc {
 	)
 }
 
+func testAccTableConfig_importTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "import/data.csv"
+  content = <<EOF
+id;value
+one;1
+two;2
+EOF
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  billing_mode     = "PAY_PER_REQUEST"
+  hash_key         = "id"
+  stream_enabled   = true
+  stream_view_type = "KEYS_ONLY"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  import_table {
+    input_format = "CSV"
+
+    input_format_options {
+      csv {
+        delimiter = ";"
+      }
+    }
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.id
+      key_prefix = "import/"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
 func testAccTableConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
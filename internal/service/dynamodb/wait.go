@@ -2,10 +2,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -77,6 +80,31 @@ func waitTableActive(conn *dynamodb.DynamoDB, tableName string, timeout time.Dur
 	return nil, err
 }
 
+func waitImportComplete(conn *dynamodb.DynamoDB, importARN string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.ImportStatusInProgress,
+		},
+		Target: []string{
+			dynamodb.ImportStatusCompleted,
+		},
+		Timeout: maxDuration(createTableTimeout, timeout),
+		Refresh: statusImport(conn, importARN),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		if code, message := aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage); code != "" || message != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", code, message))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitTableDeleted(conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) (*dynamodb.TableDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
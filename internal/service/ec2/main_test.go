@@ -0,0 +1,12 @@
+package ec2_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(acctest.RunWithPoolSummary(m))
+}
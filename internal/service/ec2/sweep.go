@@ -7,11 +7,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -27,17 +25,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
 		Name: "aws_ec2_capacity_reservation",
 		F:    sweepCapacityReservations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateway,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -45,17 +43,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleet,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -63,7 +61,7 @@ func init() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -71,12 +69,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
@@ -84,12 +82,12 @@ func init() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -97,7 +95,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -107,7 +105,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -115,7 +113,7 @@ func init() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -126,7 +124,7 @@ func init() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -135,17 +133,17 @@ func init() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -163,12 +161,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -180,30 +178,42 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
+			"aws_vpc_security_group_egress_rule",
+			"aws_vpc_security_group_ingress_rule",
 		},
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_security_group_egress_rule", &resource.Sweeper{
+		Name: "aws_vpc_security_group_egress_rule",
+		F:    sweepSecurityGroupEgressRules,
+	})
+
+	sweep.AddTestSweepers("aws_vpc_security_group_ingress_rule", &resource.Sweeper{
+		Name: "aws_vpc_security_group_ingress_rule",
+		F:    sweepSecurityGroupIngressRules,
+	})
+
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
 
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    sweepSubnets,
 		Dependencies: []string{
@@ -253,17 +263,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -274,12 +284,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -287,7 +297,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -296,12 +306,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -309,7 +319,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -318,12 +328,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -340,12 +350,12 @@ func init() {
 		F: sweepVPCs,
 	})
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -354,12 +364,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_ipam", &resource.Sweeper{
 		Name: "aws_vpc_ipam",
 		F:    sweepIPAMs,
 	})
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
@@ -371,6 +381,7 @@ func sweepCapacityReservations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
@@ -380,30 +391,28 @@ func sweepCapacityReservations(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
-	}
-
-	if len(resp.CapacityReservations) == 0 {
-		log.Print("[DEBUG] No EC2 Capacity Reservations to sweep")
-		return nil
+		return fmt.Errorf("error listing EC2 Capacity Reservations (%s): %w", region, err)
 	}
 
-	for _, r := range resp.CapacityReservations {
-		if aws.StringValue(r.State) != ec2.CapacityReservationStateCancelled && aws.StringValue(r.State) != ec2.CapacityReservationStateExpired {
-			id := aws.StringValue(r.CapacityReservationId)
+	for _, v := range resp.CapacityReservations {
+		if state := aws.StringValue(v.State); state == ec2.CapacityReservationStateCancelled || state == ec2.CapacityReservationStateExpired {
+			continue
+		}
 
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
+		r := ResourceCapacityReservation()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(v.CapacityReservationId))
 
-			opts := &ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
-			}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+			sweep.WithCreatedAt(v.CreateDate),
+			sweep.WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map()),
+		))
+	}
 
-			_, err := conn.CancelCapacityReservation(opts)
+	err = sweep.SweepOrchestrator(sweepResources)
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservations (%s): %w", region, err)
 	}
 
 	return nil
@@ -427,7 +436,7 @@ func sweepCarrierGateway(region string) error {
 			r := ResourceCarrierGateway()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(carrierGateway.CarrierGatewayId))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -613,8 +622,13 @@ func sweepEBSVolumes(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, volume := range page.Volumes {
 			id := aws.StringValue(volume.VolumeId)
 
@@ -623,16 +637,14 @@ func sweepEBSVolumes(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteVolumeInput{
-				VolumeId: aws.String(id),
-			}
-
-			log.Printf("[INFO] Deleting EC2 EBS Volume: %s", id)
-			_, err := conn.DeleteVolume(input)
+			r := ResourceEBSVolume()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 EBS Volume (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithCreatedAt(volume.CreateTime),
+				sweep.WithTags(KeyValueTags(volume.Tags).IgnoreAWS().Map()),
+			))
 		}
 
 		return !lastPage
@@ -644,7 +656,13 @@ func sweepEBSVolumes(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 EBS Volumes: %s", err)
+		return fmt.Errorf("error listing EC2 EBS Volumes (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 EBS Volumes (%s): %w", region, err)
 	}
 
 	return nil
@@ -919,7 +937,10 @@ func sweepInstances(region string) error {
 				d.SetId(id)
 				d.Set("disable_api_termination", false)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+					sweep.WithCreatedAt(instance.LaunchTime),
+					sweep.WithTags(KeyValueTags(instance.Tags).IgnoreAWS().Map()),
+				))
 			}
 		}
 		return !lastPage
@@ -1029,28 +1050,37 @@ func sweepKeyPairs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
-
-	log.Printf("Destroying the tmp keys in (%s)", client.(*conns.AWSClient).Region)
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	resp, err := conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
+		return fmt.Errorf("error listing EC2 Key Pairs (%s): %w", region, err)
 	}
 
-	keyPairs := resp.KeyPairs
-	for _, d := range keyPairs {
-		_, err := conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
-			KeyName: d.KeyName,
-		})
+	for _, v := range resp.KeyPairs {
+		r := ResourceKeyPair()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(v.KeyName))
 
-		if err != nil {
-			return fmt.Errorf("Error deleting key pairs in Sweeper: %s", err)
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+			sweep.WithName(aws.StringValue(v.KeyName)),
+			sweep.WithCreatedAt(v.CreateTime),
+			sweep.WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map()),
+		))
 	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Key Pairs (%s): %w", region, err)
+	}
+
 	return nil
 }
 
@@ -1063,28 +1093,23 @@ func sweepLaunchTemplates(region string) error {
 
 	conn := client.(*conns.AWSClient).EC2Conn
 	input := &ec2.DescribeLaunchTemplatesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeLaunchTemplatesPages(input, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
-		for _, launchTemplate := range page.LaunchTemplates {
-			id := aws.StringValue(launchTemplate.LaunchTemplateId)
-			input := &ec2.DeleteLaunchTemplateInput{
-				LaunchTemplateId: launchTemplate.LaunchTemplateId,
-			}
-
-			log.Printf("[INFO] Deleting EC2 Launch Template: %s", id)
-			_, err := conn.DeleteLaunchTemplate(input)
+		if page == nil {
+			return !lastPage
+		}
 
-			if tfawserr.ErrCodeEquals(err, "InvalidLaunchTemplateId.NotFound") {
-				continue
-			}
+		for _, v := range page.LaunchTemplates {
+			r := ResourceLaunchTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LaunchTemplateId))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Launch Template (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(v.LaunchTemplateName)),
+				sweep.WithCreatedAt(v.CreateTime),
+				sweep.WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map()),
+			))
 		}
 
 		return !lastPage
@@ -1096,10 +1121,16 @@ func sweepLaunchTemplates(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing EC2 Launch Templates: %w", err)
+		return fmt.Errorf("error listing EC2 Launch Templates (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Launch Templates (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepNATGateways(region string) error {
@@ -1206,6 +1237,7 @@ func sweepNetworkInterfaces(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
 		if page == nil {
@@ -1220,26 +1252,31 @@ func sweepNetworkInterfaces(region string) error {
 				continue
 			}
 
-			input := &ec2.DeleteNetworkInterfaceInput{
-				NetworkInterfaceId: aws.String(id),
-			}
-
-			log.Printf("[INFO] Deleting EC2 Network Interface: %s", id)
-			_, err := conn.DeleteNetworkInterface(input)
+			r := ResourceNetworkInterface()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 Network Interface (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithTags(KeyValueTags(networkInterface.TagSet).IgnoreAWS().Map()),
+			))
 		}
 
 		return !lastPage
 	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Network Interface sweep for %s: %s", region, err)
+		return nil
+	}
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Network Interface sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving EC2 Network Interfaces: %s", err)
+		return fmt.Errorf("error listing EC2 Network Interfaces (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Network Interfaces (%s): %w", region, err)
 	}
 
 	return nil
@@ -1329,8 +1366,7 @@ func sweepRouteTables(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).EC2Conn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &ec2.DescribeRouteTablesInput{}
 
@@ -1348,32 +1384,13 @@ func sweepRouteTables(region string) error {
 			isMainRouteTableAssociation := false
 
 			for _, routeTableAssociation := range routeTable.Associations {
-				if routeTableAssociation == nil {
-					continue
-				}
-
-				if aws.BoolValue(routeTableAssociation.Main) {
+				if routeTableAssociation != nil && aws.BoolValue(routeTableAssociation.Main) {
 					isMainRouteTableAssociation = true
 					break
 				}
-
-				associationID := aws.StringValue(routeTableAssociation.RouteTableAssociationId)
-
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
-
-				log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
-				_, err := conn.DisassociateRouteTable(input)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
 			}
 
+			// Main route tables are deleted with their VPC, only delete their routes.
 			if isMainRouteTableAssociation {
 				for _, route := range routeTable.Routes {
 					if route == nil {
@@ -1390,39 +1407,43 @@ func sweepRouteTables(region string) error {
 						continue
 					}
 
-					input := &ec2.DeleteRouteInput{
-						DestinationCidrBlock:     route.DestinationCidrBlock,
-						DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-						RouteTableId:             routeTable.RouteTableId,
+					var destination string
+					r := ResourceRoute()
+					d := r.Data(nil)
+					d.Set("route_table_id", id)
+
+					switch {
+					case route.DestinationCidrBlock != nil:
+						destination = aws.StringValue(route.DestinationCidrBlock)
+						d.Set("destination_cidr_block", destination)
+					case route.DestinationIpv6CidrBlock != nil:
+						destination = aws.StringValue(route.DestinationIpv6CidrBlock)
+						d.Set("destination_ipv6_cidr_block", destination)
+					case route.DestinationPrefixListId != nil:
+						destination = aws.StringValue(route.DestinationPrefixListId)
+						d.Set("destination_prefix_list_id", destination)
+					default:
+						continue
 					}
 
-					log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
-					_, err := conn.DeleteRoute(input)
+					d.SetId(RouteCreateID(id, destination))
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+						sweep.WithType("aws_route"),
+						sweep.WithTags(KeyValueTags(routeTable.Tags).IgnoreAWS().Map()),
+					))
 				}
 
 				continue
 			}
 
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
-
-			log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
-			_, err := conn.DeleteRouteTable(input)
+			r := ResourceRouteTable()
+			d := r.Data(nil)
+			d.SetId(id)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithTags(KeyValueTags(routeTable.Tags).IgnoreAWS().Map()),
+			))
 		}
 
 		return !lastPage
@@ -1430,14 +1451,20 @@ func sweepRouteTables(region string) error {
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
+		return fmt.Errorf("error listing EC2 Route Tables (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Route Tables (%s): %w", region, err)
+	}
+
+	return nil
 }
 
 func sweepSecurityGroups(region string) error {
@@ -1446,86 +1473,137 @@ func sweepSecurityGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	input := &ec2.DescribeSecurityGroupsInput{}
 
-	// Delete all non-default EC2 Security Group Rules to prevent DependencyViolation errors
 	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, sg := range page.SecurityGroups {
 			if aws.StringValue(sg.GroupName) == "default" {
 				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
 				continue
 			}
 
-			if sg.IpPermissions != nil {
-				req := &ec2.RevokeSecurityGroupIngressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissions,
-				}
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(sg.GroupId))
+			// Revoke the group's rules, and rules in other groups referencing it, to prevent DependencyViolation errors.
+			d.Set("revoke_rules_on_delete", true)
 
-				if _, err = conn.RevokeSecurityGroupIngress(req); err != nil {
-					log.Printf("[ERROR] Error revoking ingress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithName(aws.StringValue(sg.GroupName)),
+				sweep.WithTags(KeyValueTags(sg.Tags).IgnoreAWS().Map()),
+			))
+		}
 
-			if sg.IpPermissionsEgress != nil {
-				req := &ec2.RevokeSecurityGroupEgressInput{
-					GroupId:       sg.GroupId,
-					IpPermissions: sg.IpPermissionsEgress,
-				}
+		return !lastPage
+	})
 
-				if _, err = conn.RevokeSecurityGroupEgress(req); err != nil {
-					log.Printf("[ERROR] Error revoking egress rule for Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-				}
-			}
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Security Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Security Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSecurityGroupEgressRules(region string) error {
+	return sweepSecurityGroupRulesByID(region, securityGroupRuleTypeEgress)
+}
+
+func sweepSecurityGroupIngressRules(region string) error {
+	return sweepSecurityGroupRulesByID(region, securityGroupRuleTypeIngress)
+}
+
+// sweepSecurityGroupRulesByID sweeps the ingress or egress rules of all non-default EC2 Security Groups.
+func sweepSecurityGroupRulesByID(region, ruleType string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	defaultSecurityGroupIDs := make(map[string]bool)
+
+	err = conn.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"group-name": "default",
+		}),
+	}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroups {
+			defaultSecurityGroupIDs[aws.StringValue(v.GroupId)] = true
 		}
 
 		return !lastPage
 	})
 
 	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
+		log.Printf("[WARN] Skipping VPC Security Group %s Rule sweep for %s: %s", ruleType, region, err)
 		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+		return fmt.Errorf("error listing default EC2 Security Groups (%s): %w", region, err)
 	}
 
-	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
-		for _, sg := range page.SecurityGroups {
-			if aws.StringValue(sg.GroupName) == "default" {
-				log.Printf("[DEBUG] Skipping default EC2 Security Group: %s", aws.StringValue(sg.GroupId))
+	err = conn.DescribeSecurityGroupRulesPages(&ec2.DescribeSecurityGroupRulesInput{}, func(page *ec2.DescribeSecurityGroupRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SecurityGroupRules {
+			if aws.BoolValue(v.IsEgress) != (ruleType == securityGroupRuleTypeEgress) {
 				continue
 			}
 
-			input := &ec2.DeleteSecurityGroupInput{
-				GroupId: sg.GroupId,
+			if securityGroupID := aws.StringValue(v.GroupId); defaultSecurityGroupIDs[securityGroupID] {
+				log.Printf("[DEBUG] Skipping rule %s of default EC2 Security Group: %s", aws.StringValue(v.SecurityGroupRuleId), securityGroupID)
+				continue
 			}
 
-			// Handle EC2 eventual consistency
-			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteSecurityGroup(input)
-
-				if tfawserr.ErrCodeEquals(err, "DependencyViolation") {
-					return resource.RetryableError(err)
-				}
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+			r := resourceSecurityGroupRuleByID(ruleType)
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SecurityGroupRuleId))
+			d.Set("security_group_id", v.GroupId)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Security Group (%s): %s", aws.StringValue(sg.GroupId), err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping VPC Security Group %s Rule sweep for %s: %s", ruleType, region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing VPC Security Group %s Rules (%s): %w", ruleType, region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Security Groups: %w", err)
+		return fmt.Errorf("error sweeping VPC Security Group %s Rules (%s): %w", ruleType, region, err)
 	}
 
 	return nil
@@ -2068,7 +2146,7 @@ func sweepVPCEndpointServices(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting EC2 VPC Endpoint Service (%s): %w", id, err)
@@ -2214,7 +2292,7 @@ func sweepVPCs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.VpcId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTags(KeyValueTags(v.Tags).IgnoreAWS().Map())))
 		}
 
 		return !lastPage
@@ -2376,27 +2454,22 @@ func sweepIPAMs(region string) error {
 	}
 	conn := client.(*conns.AWSClient).EC2Conn
 	input := &ec2.DescribeIpamsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
 
 	err = conn.DescribeIpamsPages(input, func(page *ec2.DescribeIpamsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		cascade := true
-
 		for _, ipam := range page.Ipams {
-			id := aws.StringValue(ipam.IpamId)
-			input := &ec2.DeleteIpamInput{
-				Cascade: aws.Bool(cascade),
-				IpamId:  aws.String(id),
-			}
-
-			log.Printf("[INFO] Cascade deleting EC2 IPAM: %s", id)
-			_, err := conn.DeleteIpam(input)
+			r := ResourceIPAM()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(ipam.IpamId))
+			d.Set("cascade", true)
 
-			if err != nil {
-				log.Printf("[ERROR] Error cascade deleting EC2 IPAM (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client,
+				sweep.WithTags(KeyValueTags(ipam.Tags).IgnoreAWS().Map()),
+			))
 		}
 
 		return !lastPage
@@ -2407,6 +2480,12 @@ func sweepIPAMs(region string) error {
 		return nil
 	}
 
+	if err != nil {
+		return fmt.Errorf("error listing IPAMs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
 	if err != nil {
 		return fmt.Errorf("error sweeping IPAMs (%s): %w", region, err)
 	}
@@ -0,0 +1,9 @@
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityGroupIngressRule() *schema.Resource {
+	return resourceSecurityGroupRuleByID(securityGroupRuleTypeIngress)
+}
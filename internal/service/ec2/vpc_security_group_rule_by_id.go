@@ -0,0 +1,441 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// resourceSecurityGroupRuleByID returns the schema.Resource shared by the aws_vpc_security_group_ingress_rule
+// and aws_vpc_security_group_egress_rule resources.
+// Each resource manages exactly one security group rule, identified by the security group rule ID.
+func resourceSecurityGroupRuleByID(ruleType string) *schema.Resource {
+	r := &securityGroupRuleByID{ruleType: ruleType}
+	targets := []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: r.create,
+		ReadWithoutTimeout:   r.read,
+		UpdateWithoutTimeout: r.update,
+		DeleteWithoutTimeout: r.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_ipv4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				ExactlyOneOf: targets,
+			},
+			"cidr_ipv6": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+				ExactlyOneOf: targets,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validSecurityGroupRuleDescription,
+			},
+			"from_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+			"ip_protocol": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: ProtocolStateFunc,
+			},
+			"prefix_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: targets,
+			},
+			"referenced_security_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: targets,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"to_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(-1, 65535),
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffSecurityGroupRulePorts,
+		),
+	}
+}
+
+// customizeDiffSecurityGroupRulePorts requires from_port and to_port unless the rule applies to all protocols.
+// Otherwise a missing port would silently be sent as 0.
+func customizeDiffSecurityGroupRulePorts(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("ip_protocol") {
+		return nil
+	}
+
+	if ProtocolForValue(d.Get("ip_protocol").(string)) == "-1" {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+
+	for _, key := range []string{"from_port", "to_port"} {
+		if v := config.GetAttr(key); v.IsKnown() && v.IsNull() {
+			return fmt.Errorf("%q must be set unless \"ip_protocol\" is \"-1\"", key)
+		}
+	}
+
+	return nil
+}
+
+type securityGroupRuleByID struct {
+	ruleType string
+}
+
+func (r *securityGroupRuleByID) isEgress() bool {
+	return r.ruleType == securityGroupRuleTypeEgress
+}
+
+// resourceName returns the human-readable name of the resource, used in log and error messages.
+func (r *securityGroupRuleByID) resourceName() string {
+	if r.isEgress() {
+		return "VPC Security Group Egress Rule"
+	}
+
+	return "VPC Security Group Ingress Rule"
+}
+
+func (r *securityGroupRuleByID) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ipPermission := expandSecurityGroupRuleIPPermission(d)
+	tagSpecifications := tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeSecurityGroupRule)
+
+	var rules []*ec2.SecurityGroupRule
+
+	if r.isEgress() {
+		input := &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating %s: %s", r.resourceName(), input)
+		output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("creating %s (%s): %s", r.resourceName(), securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	} else {
+		input := &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:           aws.String(securityGroupID),
+			IpPermissions:     []*ec2.IpPermission{ipPermission},
+			TagSpecifications: tagSpecifications,
+		}
+
+		log.Printf("[DEBUG] Creating %s: %s", r.resourceName(), input)
+		output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("creating %s (%s): %s", r.resourceName(), securityGroupID, err)
+		}
+
+		rules = output.SecurityGroupRules
+	}
+
+	// Exactly one CIDR, prefix list or referenced security group is authorized, so exactly one rule is created.
+	if len(rules) != 1 || rules[0] == nil {
+		return diag.Errorf("creating %s (%s): %d security group rules returned", r.resourceName(), securityGroupID, len(rules))
+	}
+
+	d.SetId(aws.StringValue(rules[0].SecurityGroupRuleId))
+
+	return r.read(ctx, d, meta)
+}
+
+func (r *securityGroupRuleByID) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFoundContext(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindSecurityGroupRuleByID(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s %s not found, removing from state", r.resourceName(), d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading %s (%s): %s", r.resourceName(), d.Id(), err)
+	}
+
+	rule := outputRaw.(*ec2.SecurityGroupRule)
+
+	if aws.BoolValue(rule.IsEgress) != r.isEgress() {
+		return diag.Errorf("reading %s (%s): security group rule is not an %s rule", r.resourceName(), d.Id(), r.ruleType)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: aws.StringValue(rule.GroupOwnerId),
+		Resource:  fmt.Sprintf("security-group-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("cidr_ipv4", rule.CidrIpv4)
+	d.Set("cidr_ipv6", rule.CidrIpv6)
+	d.Set("description", rule.Description)
+	d.Set("ip_protocol", rule.IpProtocol)
+	d.Set("prefix_list_id", rule.PrefixListId)
+	d.Set("referenced_security_group_id", flattenSecurityGroupRuleReferencedGroupID(rule))
+	d.Set("security_group_id", rule.GroupId)
+	d.Set("security_group_rule_id", rule.SecurityGroupRuleId)
+	setSecurityGroupRulePorts(d, rule)
+
+	tags := KeyValueTags(rule.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func (r *securityGroupRuleByID) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId: aws.String(d.Get("security_group_id").(string)),
+			SecurityGroupRules: []*ec2.SecurityGroupRuleUpdate{{
+				SecurityGroupRule:   expandSecurityGroupRuleRequest(d),
+				SecurityGroupRuleId: aws.String(d.Id()),
+			}},
+		}
+
+		log.Printf("[DEBUG] Updating %s: %s", r.resourceName(), input)
+		_, err := conn.ModifySecurityGroupRulesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating %s (%s): %s", r.resourceName(), d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTagsWithContext(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating %s (%s) tags: %s", r.resourceName(), d.Id(), err)
+		}
+	}
+
+	return r.read(ctx, d, meta)
+}
+
+func (r *securityGroupRuleByID) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	securityGroupID := d.Get("security_group_id").(string)
+	ruleIDs := aws.StringSlice([]string{d.Id()})
+
+	log.Printf("[DEBUG] Deleting %s: %s", r.resourceName(), d.Id())
+	var err error
+
+	if r.isEgress() {
+		_, err = conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	} else {
+		_, err = conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: ruleIDs,
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting %s (%s): %s", r.resourceName(), d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandSecurityGroupRuleIPPermission returns the IP permission authorizing the configured rule.
+func expandSecurityGroupRuleIPPermission(d *schema.ResourceData) *ec2.IpPermission {
+	protocol := ProtocolForValue(d.Get("ip_protocol").(string))
+	description := aws.String(d.Get("description").(string))
+
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(protocol),
+	}
+
+	if protocol != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v.(string)),
+			Description: description,
+		}}
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			Description:  description,
+			PrefixListId: aws.String(v.(string)),
+		}}
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		userID, groupID := expandSecurityGroupRuleReferencedGroupID(v.(string))
+
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+			GroupId:     aws.String(groupID),
+		}}
+
+		if userID != "" {
+			apiObject.UserIdGroupPairs[0].UserId = aws.String(userID)
+		}
+	}
+
+	return apiObject
+}
+
+// expandSecurityGroupRuleRequest returns the configured rule for a ModifySecurityGroupRules request.
+func expandSecurityGroupRuleRequest(d *schema.ResourceData) *ec2.SecurityGroupRuleRequest {
+	protocol := ProtocolForValue(d.Get("ip_protocol").(string))
+
+	apiObject := &ec2.SecurityGroupRuleRequest{
+		Description: aws.String(d.Get("description").(string)),
+		IpProtocol:  aws.String(protocol),
+	}
+
+	if protocol != "-1" {
+		apiObject.FromPort = aws.Int64(int64(d.Get("from_port").(int)))
+		apiObject.ToPort = aws.Int64(int64(d.Get("to_port").(int)))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv4"); ok {
+		apiObject.CidrIpv4 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cidr_ipv6"); ok {
+		apiObject.CidrIpv6 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("prefix_list_id"); ok {
+		apiObject.PrefixListId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("referenced_security_group_id"); ok {
+		// ModifySecurityGroupRules identifies the referenced security group by ID only.
+		_, groupID := expandSecurityGroupRuleReferencedGroupID(v.(string))
+		apiObject.ReferencedGroupId = aws.String(groupID)
+	}
+
+	return apiObject
+}
+
+// setSecurityGroupRulePorts sets a rule's from_port and to_port.
+// Ports don't apply to rules for all protocols, for which EC2 returns -1, so they are left unset.
+func setSecurityGroupRulePorts(d *schema.ResourceData, rule *ec2.SecurityGroupRule) {
+	if ProtocolForValue(aws.StringValue(rule.IpProtocol)) == "-1" {
+		d.Set("from_port", nil)
+		d.Set("to_port", nil)
+	} else {
+		d.Set("from_port", rule.FromPort)
+		d.Set("to_port", rule.ToPort)
+	}
+}
+
+// expandSecurityGroupRuleReferencedGroupID splits a referenced security group ID, optionally prefixed with
+// the AWS account ID owning a security group in a peered VPC ("123456789012/sg-12345678"), into its parts.
+func expandSecurityGroupRuleReferencedGroupID(v string) (string, string) {
+	if userID, groupID, ok := strings.Cut(v, "/"); ok {
+		return userID, groupID
+	}
+
+	return "", v
+}
+
+// flattenSecurityGroupRuleReferencedGroupID returns the ID of a rule's referenced security group,
+// prefixed with the owning AWS account ID if it is not owned by the security group's account.
+func flattenSecurityGroupRuleReferencedGroupID(rule *ec2.SecurityGroupRule) *string {
+	if rule.ReferencedGroupInfo == nil {
+		return nil
+	}
+
+	groupID := aws.StringValue(rule.ReferencedGroupInfo.GroupId)
+
+	if userID := aws.StringValue(rule.ReferencedGroupInfo.UserId); userID != "" && userID != aws.StringValue(rule.GroupOwnerId) {
+		return aws.String(fmt.Sprintf("%s/%s", userID, groupID))
+	}
+
+	return aws.String(groupID)
+}
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,12 +9,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -25,10 +28,10 @@ const (
 
 func ResourceRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRuleCreate,
-		Read:   resourceRuleRead,
-		Update: resourceRuleUpdate,
-		Delete: resourceRuleDelete,
+		Create:               resourceRuleCreate,
+		Read:                 resourceRuleRead,
+		UpdateWithoutTimeout: resourceRuleUpdate,
+		Delete:               resourceRuleDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -73,6 +76,7 @@ func ResourceRule() *schema.Resource {
 					json, _ := structure.NormalizeJsonString(v.(string))
 					return json
 				},
+				DiffSuppressFunc: verify.EventPatternNormalizer.SuppressDiffs,
 			},
 			"description": {
 				Type:         schema.TypeString,
@@ -225,19 +229,20 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := verify.EventPatternNormalizer.ChangeWarnings(d, "event_pattern")
 	conn := meta.(*conns.AWSClient).EventsConn
 
 	_, ruleName, err := RuleParseResourceID(d.Id())
 
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	input, err := buildPutRuleInputStruct(d, ruleName)
 
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	// IAM Roles take some time to propagate
@@ -260,7 +265,7 @@ func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error updating EventBridge Rule (%s): %w", d.Id(), err)
+		return errs.AppendErrorf(diags, "updating EventBridge Rule (%s): %s", d.Id(), err)
 	}
 
 	arn := d.Get("arn").(string)
@@ -271,15 +276,15 @@ func resourceRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] Unable to update tags for EventBridge Rule %s: %s", d.Id(), err)
-			return resourceRuleRead(d, meta)
+			return append(diags, diag.FromErr(resourceRuleRead(d, meta))...)
 		}
 
 		if err != nil {
-			return fmt.Errorf("error updating EventBridge Rule tags: %w", err)
+			return errs.AppendErrorf(diags, "updating EventBridge Rule tags: %s", err)
 		}
 	}
 
-	return resourceRuleRead(d, meta)
+	return append(diags, diag.FromErr(resourceRuleRead(d, meta))...)
 }
 
 func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
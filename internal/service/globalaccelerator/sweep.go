@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 	})
@@ -55,7 +55,7 @@ func sweepAccelerators(region string) error {
 			r := ResourceAccelerator()
 			d := r.Data(nil)
 			d.SetId(arn)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Global Accelerator Accelerator (%s): %s", arn, err)
@@ -96,7 +96,7 @@ func sweepEndpointGroups(client interface{}, listenerArn *string) *multierror.Er
 		r := ResourceEndpointGroup()
 		d := r.Data(nil)
 		d.SetId(arn)
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Global Accelerator endpoint group (%s): %s", arn, err)
@@ -135,7 +135,7 @@ func sweepListeners(client interface{}, acceleratorArn *string) *multierror.Erro
 		r := ResourceListener()
 		d := r.Data(nil)
 		d.SetId(arn)
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Global Accelerator listener (%s): %s", arn, err)
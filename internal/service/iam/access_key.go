@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -56,23 +57,10 @@ func ResourceAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"encrypted_secret": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"encrypted_ses_smtp_password_v4": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"key_fingerprint": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"pgp_key": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
-			},
+			"encrypted_secret":               encryption.EncryptedValueSchema(),
+			"encrypted_ses_smtp_password_v4": encryption.EncryptedValueSchema(),
+			"key_fingerprint":                encryption.KeyFingerprintSchema(),
+			"pgp_key":                        encryption.PGPKeySchema(),
 			"secret": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -89,6 +77,7 @@ func ResourceAccessKey() *schema.Resource {
 				Default:      iam.StatusTypeActive,
 				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
 			},
+			"use_provider_pgp_key": encryption.UseProviderPGPKeySchema(),
 			"user": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -125,34 +114,17 @@ func resourceAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting SES SigV4 SMTP Password from Secret Access Key: %s", err)
 	}
 
-	if v, ok := d.GetOk("pgp_key"); ok {
-		pgpKey := v.(string)
-		encryptionKey, err := retrieveGPGKey(pgpKey)
-		if err != nil {
-			return err
-		}
-		fingerprint, encrypted, err := encryptValue(encryptionKey, *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
-		if err != nil {
-			return err
-		}
-
-		d.Set("key_fingerprint", fingerprint)
-		d.Set("encrypted_secret", encrypted)
-
-		_, encrypted, err = encryptValue(encryptionKey, sesSMTPPasswordV4, "SES SMTP password")
-		if err != nil {
-			return err
-		}
-
-		d.Set("encrypted_ses_smtp_password_v4", encrypted)
-	} else {
-		if err := d.Set("secret", createResp.AccessKey.SecretAccessKey); err != nil {
-			return err
-		}
+	encryptionKey, err := encryption.ResolvePGPKey(d, meta.(*conns.AWSClient).PGPKey)
+	if err != nil {
+		return err
+	}
 
-		if err := d.Set("ses_smtp_password_v4", sesSMTPPasswordV4); err != nil {
-			return err
-		}
+	err = encryption.SetSecrets(d, encryptionKey, map[string]string{
+		"secret":               aws.StringValue(createResp.AccessKey.SecretAccessKey),
+		"ses_smtp_password_v4": sesSMTPPasswordV4,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting IAM Access Key (%s) secrets: %w", d.Id(), err)
 	}
 
 	if v, ok := d.GetOk("status"); ok && v.(string) == iam.StatusTypeInactive {
@@ -0,0 +1,17 @@
+package iam
+
+const (
+	resourceHandlingOptionEC2VPCEBS                 = "EC2-VPC-EBS"
+	resourceHandlingOptionEC2VPCEBSSubnet           = "EC2-VPC-EBS-Subnet"
+	resourceHandlingOptionEC2VPCInstanceStore       = "EC2-VPC-InstanceStore"
+	resourceHandlingOptionEC2VPCInstanceStoreSubnet = "EC2-VPC-InstanceStore-Subnet"
+)
+
+func resourceHandlingOption_Values() []string {
+	return []string{
+		resourceHandlingOptionEC2VPCEBS,
+		resourceHandlingOptionEC2VPCEBSSubnet,
+		resourceHandlingOptionEC2VPCInstanceStore,
+		resourceHandlingOptionEC2VPCInstanceStoreSubnet,
+	}
+}
@@ -1,39 +0,0 @@
-package iam
-
-import (
-	"encoding/base64"
-	"fmt"
-	"strings"
-
-	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
-)
-
-// retrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
-// the public key from the keybase service if the parameter is a keybase username
-// prefixed with the phrase "keybase:"
-func retrieveGPGKey(pgpKey string) (string, error) {
-	const keybasePrefix = "keybase:"
-
-	encryptionKey := pgpKey
-	if strings.HasPrefix(pgpKey, keybasePrefix) {
-		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{pgpKey})
-		if err != nil {
-			return "", fmt.Errorf("Error retrieving Public Key for %s: %w", pgpKey, err)
-		}
-		encryptionKey = publicKeys[pgpKey]
-	}
-
-	return encryptionKey, nil
-}
-
-// encryptValue encrypts the given value with the given encryption key. Description
-// should be set such that errors return a meaningful user-facing response.
-func encryptValue(encryptionKey, value, description string) (string, string, error) {
-	fingerprints, encryptedValue, err :=
-		pgpkeys.EncryptShares([][]byte{[]byte(value)}, []string{encryptionKey})
-	if err != nil {
-		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
-	}
-
-	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
-}
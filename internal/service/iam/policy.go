@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,10 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -24,10 +27,10 @@ const (
 
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePolicyCreate,
-		Read:   resourcePolicyRead,
-		Update: resourcePolicyUpdate,
-		Delete: resourcePolicyDelete,
+		Create:               resourcePolicyCreate,
+		Read:                 resourcePolicyRead,
+		UpdateWithoutTimeout: resourcePolicyUpdate,
+		Delete:               resourcePolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -45,10 +48,12 @@ func ResourcePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return verify.SuppressEquivalentPolicyDiffs(k, old, new, d) || verify.PolicyDocumentNormalizer.SuppressDiffs(k, old, new, d)
+				},
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -275,18 +280,19 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := verify.PolicyDocumentNormalizer.ChangeWarnings(d, "policy")
 	conn := meta.(*conns.AWSClient).IAMConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		if err := policyPruneVersions(d.Id(), conn); err != nil {
-			return err
+			return append(diags, diag.FromErr(err)...)
 		}
 
 		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 
 		if err != nil {
-			return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+			return errs.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policy, err)
 		}
 
 		request := &iam.CreatePolicyVersionInput{
@@ -296,7 +302,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if _, err := conn.CreatePolicyVersion(request); err != nil {
-			return fmt.Errorf("error updating IAM policy %s: %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "updating IAM policy %s: %s", d.Id(), err)
 		}
 	}
 
@@ -308,15 +314,15 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		// Some partitions (i.e., ISO) may not support tagging, giving error
 		if verify.ErrorISOUnsupported(conn.PartitionID, err) {
 			log.Printf("[WARN] failed updating tags for IAM Policy (%s): %s", d.Id(), err)
-			return resourcePolicyRead(d, meta)
+			return append(diags, diag.FromErr(resourcePolicyRead(d, meta))...)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed updating tags for IAM Policy (%s): %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "failed updating tags for IAM Policy (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourcePolicyRead(d, meta)
+	return append(diags, diag.FromErr(resourcePolicyRead(d, meta))...)
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
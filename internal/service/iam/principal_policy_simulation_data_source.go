@@ -0,0 +1,363 @@
+package iam
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_handling_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(resourceHandlingOption_Values(), false),
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     expandSortedStringSet(d.Get("action_names").(*schema.Set)),
+		PolicySourceArn: aws.String(d.Get("principal_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("additional_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		input.CallerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandPolicySimulationContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = expandSortedStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_handling_option"); ok {
+		input.ResourceHandlingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		input.ResourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		input.ResourcePolicy = aws.String(v.(string))
+	}
+
+	results, err := findPrincipalPolicySimulationResults(ctx, client, input)
+
+	if err != nil {
+		return diag.Errorf("simulating IAM Principal Policy (%s): %s", aws.StringValue(input.PolicySourceArn), err)
+	}
+
+	tfList := flattenPolicySimulationEvaluationResults(results)
+	allAllowed := len(tfList) > 0
+
+	for _, tfMapRaw := range tfList {
+		if !tfMapRaw.(map[string]interface{})["allowed"].(bool) {
+			allAllowed = false
+			break
+		}
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(input.String())))
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", tfList); err != nil {
+		return diag.Errorf("setting results: %s", err)
+	}
+
+	return nil
+}
+
+// policySimulationCaches holds the policy simulation results cache of each provider instance, keyed by the instance's
+// *conns.AWSClient.
+var policySimulationCaches sync.Map
+
+// policySimulationCache returns the provider instance's policy simulation results cache, creating it if necessary.
+func policySimulationCache(client *conns.AWSClient) *sync.Map {
+	v, _ := policySimulationCaches.LoadOrStore(client, &sync.Map{})
+
+	return v.(*sync.Map)
+}
+
+// findPrincipalPolicySimulationResults returns the results of a policy simulation.
+// Results are cached for the lifetime of the provider instance, i.e. a single plan or apply, so that identical
+// simulations are only sent to the IAM API once. They are never shared between provider configurations.
+func findPrincipalPolicySimulationResults(ctx context.Context, client *conns.AWSClient, input *iam.SimulatePrincipalPolicyInput) ([]*iam.EvaluationResult, error) {
+	cache := policySimulationCache(client)
+	key := input.String()
+
+	if v, ok := cache.Load(key); ok {
+		return v.([]*iam.EvaluationResult), nil
+	}
+
+	conn := client.IAMConn
+
+	var output []*iam.EvaluationResult
+
+	err := conn.SimulatePrincipalPolicyPagesWithContext(ctx, input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EvaluationResults {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	cache.Store(key, output)
+
+	return output, nil
+}
+
+// expandSortedStringSet returns a set's strings in sorted order, so that equivalent configurations produce identical
+// simulation requests.
+func expandSortedStringSet(configured *schema.Set) []*string {
+	vs := flex.ExpandStringValueSet(configured)
+	sort.Strings(vs)
+
+	return aws.StringSlice(vs)
+}
+
+func expandPolicySimulationContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: expandSortedStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.StringValue(apiObjects[i].ContextKeyName) < aws.StringValue(apiObjects[j].ContextKeyName)
+	})
+
+	return apiObjects
+}
+
+func flattenPolicySimulationEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenPolicySimulationEvaluationResult(apiObject)...)
+	}
+
+	return tfList
+}
+
+// flattenPolicySimulationEvaluationResult returns one result per resource evaluated for the action.
+func flattenPolicySimulationEvaluationResult(apiObject *iam.EvaluationResult) []interface{} {
+	actionName := aws.StringValue(apiObject.EvalActionName)
+
+	if len(apiObject.ResourceSpecificResults) == 0 {
+		return []interface{}{
+			policySimulationResult(actionName, apiObject.EvalResourceName, apiObject.EvalDecision, apiObject.EvalDecisionDetails, apiObject.MatchedStatements, apiObject.MissingContextValues),
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.ResourceSpecificResults {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, policySimulationResult(actionName, v.EvalResourceName, v.EvalResourceDecision, v.EvalDecisionDetails, v.MatchedStatements, v.MissingContextValues))
+	}
+
+	return tfList
+}
+
+func policySimulationResult(actionName string, resourceName, decision *string, decisionDetails map[string]*string, matchedStatements []*iam.Statement, missingContextValues []*string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"action_name":          actionName,
+		"allowed":              aws.StringValue(decision) == iam.PolicyEvaluationDecisionTypeAllowed,
+		"decision":             aws.StringValue(decision),
+		"decision_details":     aws.StringValueMap(decisionDetails),
+		"missing_context_keys": aws.StringValueSlice(missingContextValues),
+		"resource_arn":         aws.StringValue(resourceName),
+	}
+
+	var statements []interface{}
+
+	for _, v := range matchedStatements {
+		if v == nil {
+			continue
+		}
+
+		statements = append(statements, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(v.SourcePolicyId),
+			"source_policy_type": aws.StringValue(v.SourcePolicyType),
+		})
+	}
+
+	tfMap["matched_statements"] = statements
+
+	return tfMap
+}
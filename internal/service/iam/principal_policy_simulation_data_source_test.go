@@ -0,0 +1,136 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allowedDataSourceName := "data.aws_iam_principal_policy_simulation.allowed"
+	deniedDataSourceName := "data.aws_iam_principal_policy_simulation.denied"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allowedDataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeAllowed),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.0.matched_statements.0.source_policy_type", "IAM Policy"),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "results.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_additionalPolicies(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_additionalPolicies(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeExplicitDeny),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_principal_policy_simulation" "allowed" {
+  principal_arn = aws_iam_role.test.arn
+  action_names  = ["s3:GetObject"]
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+data "aws_iam_principal_policy_simulation" "denied" {
+  principal_arn = aws_iam_role.test.arn
+  action_names  = ["s3:GetObject", "s3:PutObject"]
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_additionalPolicies(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iam_principal_policy_simulation" "test" {
+  principal_arn = aws_iam_role.test.arn
+  action_names  = ["s3:GetObject"]
+  resource_arns = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  additional_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Deny"
+      Resource = "*"
+    }]
+  })]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
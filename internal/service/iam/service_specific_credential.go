@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -25,6 +26,9 @@ func ResourceServiceSpecificCredential() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"encrypted_service_password": encryption.EncryptedValueSchema(),
+			"key_fingerprint":            encryption.KeyFingerprintSchema(),
+			"pgp_key":                    encryption.PGPKeySchema(),
 			"service_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -36,6 +40,7 @@ func ResourceServiceSpecificCredential() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"use_provider_pgp_key": encryption.UseProviderPGPKeySchema(),
 			"status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -75,7 +80,15 @@ func resourceServiceSpecificCredentialCreate(d *schema.ResourceData, meta interf
 	cred := out.ServiceSpecificCredential
 
 	d.SetId(fmt.Sprintf("%s:%s:%s", aws.StringValue(cred.ServiceName), aws.StringValue(cred.UserName), aws.StringValue(cred.ServiceSpecificCredentialId)))
-	d.Set("service_password", cred.ServicePassword)
+
+	encryptionKey, err := encryption.ResolvePGPKey(d, meta.(*conns.AWSClient).PGPKey)
+	if err != nil {
+		return err
+	}
+
+	if err := encryption.SetSecrets(d, encryptionKey, map[string]string{"service_password": aws.StringValue(cred.ServicePassword)}); err != nil {
+		return fmt.Errorf("error setting IAM Service Specific Credential (%s) password: %w", d.Id(), err)
+	}
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != iam.StatusTypeActive {
 		updateInput := &iam.UpdateServiceSpecificCredentialInput{
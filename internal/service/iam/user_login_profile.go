@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -37,11 +38,8 @@ func ResourceUserLoginProfile() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
+			"pgp_key":              encryption.PGPKeySchema(),
+			"use_provider_pgp_key": encryption.UseProviderPGPKeySchema(),
 			"password_reset_required": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -56,14 +54,8 @@ func ResourceUserLoginProfile() *schema.Resource {
 				ValidateFunc: validation.IntBetween(5, 128),
 			},
 
-			"key_fingerprint": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"encrypted_password": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			"key_fingerprint":    encryption.KeyFingerprintSchema(),
+			"encrypted_password": encryption.EncryptedValueSchema(),
 			"password": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -149,21 +141,13 @@ func resourceUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(aws.StringValue(createResp.LoginProfile.UserName))
 
-	if v, ok := d.GetOk("pgp_key"); ok {
-		encryptionKey, err := retrieveGPGKey(v.(string))
-		if err != nil {
-			return fmt.Errorf("error retrieving GPG Key during IAM User Login Profile (%s) creation: %w", username, err)
-		}
-
-		fingerprint, encrypted, err := encryptValue(encryptionKey, initialPassword, "Password")
-		if err != nil {
-			return fmt.Errorf("error encrypting password during IAM User Login Profile (%s) creation: %w", username, err)
-		}
+	encryptionKey, err := encryption.ResolvePGPKey(d, meta.(*conns.AWSClient).PGPKey)
+	if err != nil {
+		return fmt.Errorf("error retrieving GPG Key during IAM User Login Profile (%s) creation: %w", username, err)
+	}
 
-		d.Set("key_fingerprint", fingerprint)
-		d.Set("encrypted_password", encrypted)
-	} else {
-		d.Set("password", initialPassword)
+	if err := encryption.SetSecrets(d, encryptionKey, map[string]string{"password": initialPassword}); err != nil {
+		return fmt.Errorf("error encrypting password during IAM User Login Profile (%s) creation: %w", username, err)
 	}
 
 	return nil
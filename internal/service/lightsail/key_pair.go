@@ -1,10 +1,8 @@
 package lightsail
 
 import (
-	"encoding/base64"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,7 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/vault/helper/pgpkeys"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 )
 
 func ResourceKeyPair() *schema.Resource {
@@ -38,11 +36,8 @@ func ResourceKeyPair() *schema.Resource {
 			},
 
 			// optional fields
-			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
+			"pgp_key":              encryption.PGPKeySchema(),
+			"use_provider_pgp_key": encryption.UseProviderPGPKeySchema(),
 
 			// additional info returned from the API
 			"arn": {
@@ -68,13 +63,12 @@ func ResourceKeyPair() *schema.Resource {
 
 			// encrypted fields if pgp_key is given
 			"encrypted_fingerprint": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"encrypted_private_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "Use the attribute \"key_fingerprint\" instead.",
 			},
+			"encrypted_private_key": encryption.EncryptedValueSchema(),
+			"key_fingerprint":       encryption.KeyFingerprintSchema(),
 		},
 	}
 }
@@ -118,19 +112,20 @@ func resourceKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
 		// if a pgp_key is given, else we store the private_key in state
 		d.Set("public_key", resp.PublicKeyBase64)
 
-		// encrypt private key if pgp_key is given
-		pgpKey, err := retrieveGPGKey(d.Get("pgp_key").(string))
+		// encrypt private key if a PGP key is given
+		pgpKey, err := encryption.ResolvePGPKey(d, meta.(*conns.AWSClient).PGPKey)
 		if err != nil {
 			return err
 		}
 		if pgpKey != "" {
-			fingerprint, encrypted, err := encryptValue(pgpKey, *resp.PrivateKeyBase64, "Lightsail Private Key")
+			fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, aws.StringValue(resp.PrivateKeyBase64), "Lightsail Private Key")
 			if err != nil {
 				return err
 			}
 
 			d.Set("encrypted_fingerprint", fingerprint)
 			d.Set("encrypted_private_key", encrypted)
+			d.Set("key_fingerprint", fingerprint)
 		} else {
 			d.Set("private_key", resp.PrivateKeyBase64)
 		}
@@ -226,33 +221,3 @@ func resourceKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-
-// retrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
-// the public key from the keybase service if the parameter is a keybase username
-// prefixed with the phrase "keybase:"
-func retrieveGPGKey(pgpKey string) (string, error) {
-	const keybasePrefix = "keybase:"
-
-	encryptionKey := pgpKey
-	if strings.HasPrefix(pgpKey, keybasePrefix) {
-		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{pgpKey})
-		if err != nil {
-			return "", fmt.Errorf("Error retrieving Public Key for %s: %w", pgpKey, err)
-		}
-		encryptionKey = publicKeys[pgpKey]
-	}
-
-	return encryptionKey, nil
-}
-
-// encryptValue encrypts the given value with the given encryption key. Description
-// should be set such that errors return a meaningful user-facing response.
-func encryptValue(encryptionKey, value, description string) (string, string, error) {
-	fingerprints, encryptedValue, err :=
-		pgpkeys.EncryptShares([][]byte{[]byte(value)}, []string{encryptionKey})
-	if err != nil {
-		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
-	}
-
-	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
-}
@@ -67,6 +67,7 @@ func TestAccLightsailKeyPair_publicKey(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
 					resource.TestCheckNoResourceAttr(resourceName, "encrypted_fingerprint"),
 					resource.TestCheckNoResourceAttr(resourceName, "encrypted_private_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "key_fingerprint"),
 					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
 				),
 			},
@@ -94,6 +95,7 @@ func TestAccLightsailKeyPair_encrypted(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
 					resource.TestCheckResourceAttrSet(resourceName, "encrypted_fingerprint"),
 					resource.TestCheckResourceAttrSet(resourceName, "encrypted_private_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_fingerprint", resourceName, "encrypted_fingerprint"),
 					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
 					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
 				),
@@ -1,6 +1,7 @@
 package organizations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,10 +23,10 @@ import (
 
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAccountCreate,
-		Read:   resourceAccountRead,
-		Update: resourceAccountUpdate,
-		Delete: resourceAccountDelete,
+		CreateWithoutTimeout: resourceAccountCreate,
+		Read:                 resourceAccountRead,
+		Update:               resourceAccountUpdate,
+		Delete:               resourceAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -101,7 +103,7 @@ func ResourceAccount() *schema.Resource {
 	}
 }
 
-func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -119,6 +121,7 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	s, err := createAccount(
+		ctx,
 		conn,
 		d.Get("name").(string),
 		d.Get("email").(string),
@@ -129,13 +132,13 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	)
 
 	if err != nil {
-		return fmt.Errorf("error creating AWS Organizations Account (%s): %w", d.Get("name").(string), err)
+		return diag.Errorf("error creating AWS Organizations Account (%s): %s", d.Get("name").(string), err)
 	}
 
 	output, err := waitAccountCreated(conn, aws.StringValue(s.Id))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for AWS Organizations Account (%s) create: %w", d.Get("name").(string), err)
+		return diag.Errorf("error waiting for AWS Organizations Account (%s) create: %s", d.Get("name").(string), err)
 	}
 
 	d.SetId(aws.StringValue(output.AccountId))
@@ -145,7 +148,7 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 		oldParentAccountID, err := findParentAccountID(conn, d.Id())
 
 		if err != nil {
-			return fmt.Errorf("error reading AWS Organizations Account (%s) parent: %w", d.Id(), err)
+			return diag.Errorf("error reading AWS Organizations Account (%s) parent: %s", d.Id(), err)
 		}
 
 		if newParentAccountID := v.(string); newParentAccountID != oldParentAccountID {
@@ -156,13 +159,13 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
-			if _, err := conn.MoveAccount(input); err != nil {
-				return fmt.Errorf("error moving AWS Organizations Account (%s): %w", d.Id(), err)
+			if _, err := conn.MoveAccountWithContext(ctx, input); err != nil {
+				return diag.Errorf("error moving AWS Organizations Account (%s): %s", d.Id(), err)
 			}
 		}
 	}
 
-	return resourceAccountRead(d, meta)
+	return diag.FromErr(resourceAccountRead(d, meta))
 }
 
 func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
@@ -279,7 +282,7 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func createAccount(conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
+func createAccount(ctx context.Context, conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
 	if govCloud {
 		input := &organizations.CreateGovCloudAccountInput{
 			AccountName: aws.String(name),
@@ -299,11 +302,11 @@ func createAccount(conn *organizations.Organizations, name, email string, iamUse
 		}
 
 		log.Printf("[DEBUG] Creating AWS Organizations Account with GovCloud Account: %s", input)
-		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(4*time.Minute,
-			func() (interface{}, error) {
-				return conn.CreateGovCloudAccount(input)
+		outputRaw, err := tfresource.RetryWithPolicy(ctx, 4*time.Minute, retryPolicy(conn),
+			func(ctx context.Context) (interface{}, error) {
+				return conn.CreateGovCloudAccountWithContext(ctx, input)
 			},
-			organizations.ErrCodeFinalizingOrganizationException,
+			retryFinalizingOrganization,
 		)
 
 		if err != nil {
@@ -331,11 +334,11 @@ func createAccount(conn *organizations.Organizations, name, email string, iamUse
 	}
 
 	log.Printf("[DEBUG] Creating AWS Organizations Account: %s", input)
-	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(4*time.Minute,
-		func() (interface{}, error) {
-			return conn.CreateAccount(input)
+	outputRaw, err := tfresource.RetryWithPolicy(ctx, 4*time.Minute, retryPolicy(conn),
+		func(ctx context.Context) (interface{}, error) {
+			return conn.CreateAccountWithContext(ctx, input)
 		},
-		organizations.ErrCodeFinalizingOrganizationException,
+		retryFinalizingOrganization,
 	)
 
 	if err != nil {
@@ -1,6 +1,7 @@
 package organizations
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -16,9 +18,9 @@ import (
 
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePolicyAttachmentCreate,
-		Read:   resourcePolicyAttachmentRead,
-		Delete: resourcePolicyAttachmentDelete,
+		CreateWithoutTimeout: resourcePolicyAttachmentCreate,
+		Read:                 resourcePolicyAttachmentRead,
+		Delete:               resourcePolicyAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -39,7 +41,7 @@ func ResourcePolicyAttachment() *schema.Resource {
 	}
 }
 
-func resourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
 	policyID := d.Get("policy_id").(string)
@@ -50,17 +52,17 @@ func resourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 		TargetId: aws.String(targetID),
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(4*time.Minute, func() (interface{}, error) {
-		return conn.AttachPolicy(input)
-	}, organizations.ErrCodeFinalizingOrganizationException)
+	_, err := tfresource.RetryWithPolicy(ctx, 4*time.Minute, retryPolicy(conn), func(ctx context.Context) (interface{}, error) {
+		return conn.AttachPolicyWithContext(ctx, input)
+	}, retryFinalizingOrganization)
 
 	if err != nil {
-		return fmt.Errorf("creating Organizations Policy Attachment (%s): %w", id, err)
+		return diag.Errorf("creating Organizations Policy Attachment (%s): %s", id, err)
 	}
 
 	d.SetId(id)
 
-	return resourcePolicyAttachmentRead(d, meta)
+	return diag.FromErr(resourcePolicyAttachmentRead(d, meta))
 }
 
 func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,30 @@
+package organizations
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// retryPolicy returns the retry policy for Organizations API calls made with the specified connection.
+// Organizations throttles requests at a low per-account rate, so all callers using a provider's connection share a single throttle.
+func retryPolicy(conn *organizations.Organizations) *tfresource.RetryPolicy {
+	return &tfresource.RetryPolicy{
+		Backoff:        tfresource.ExponentialBackoffWithFullJitter(1*time.Second, 30*time.Second),
+		AttemptTimeout: 1 * time.Minute,
+		Throttle:       tfresource.SharedThrottle(conn, names.Organizations, 2, 5),
+	}
+}
+
+// retryFinalizingOrganization retries while the organization is being finalized, or when throttled.
+func retryFinalizingOrganization(err error) (bool, error) {
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeFinalizingOrganizationException) || errs.IsCategory(err, errs.CategoryThrottling) {
+		return true, err
+	}
+
+	return false, err
+}
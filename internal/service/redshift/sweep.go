@@ -4,20 +4,24 @@
 package redshift
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_redshift_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -25,42 +29,42 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
 		Name: "aws_redshift_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_client_certificate", &resource.Sweeper{
 		Name: "aws_redshift_hsm_client_certificate",
 		F:    sweepHSMClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_hsm_configuration", &resource.Sweeper{
 		Name: "aws_redshift_hsm_configuration",
 		F:    sweepHSMConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_authentication_profile", &resource.Sweeper{
 		Name: "aws_redshift_authentication_profile",
 		F:    sweepAuthenticationProfiles,
 	})
 
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_scheduled_action", &resource.Sweeper{
 		Name: "aws_redshift_scheduled_action",
 		F:    sweepScheduledActions,
 	})
 
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    sweepSnapshotSchedules,
 	})
 
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -75,6 +79,8 @@ func sweepClusterSnapshots(region string) error {
 		return fmt.Errorf("getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).RedshiftConn
+	sweepables := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
 
 	err = conn.DescribeClusterSnapshotsPages(&redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
 		if len(resp.Snapshots) == 0 {
@@ -90,24 +96,72 @@ func sweepClusterSnapshots(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Redshift cluster snapshot: %s", id)
-			_, err := conn.DeleteClusterSnapshot(&redshift.DeleteClusterSnapshotInput{
-				SnapshotIdentifier: s.SnapshotIdentifier,
+			sweepables = append(sweepables, clusterSnapshotSweeper{
+				conn:      conn,
+				id:        id,
+				createdAt: s.SnapshotCreateTime,
+				tags:      KeyValueTags(s.Tags).IgnoreAWS().Map(),
 			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster snapshot (%s): %s", id, err)
-			}
 		}
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Redshift Cluster Snapshot sweep for %s: %s", region, err)
+		errs = multierror.Append(errs, fmt.Errorf("describing Redshift Cluster Snapshots: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepables); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Redshift Cluster Snapshots for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Redshift Cluster Snapshot sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// clusterSnapshotSweeper deletes a manual cluster snapshot, for which there's no resource.
+type clusterSnapshotSweeper struct {
+	conn      *redshift.Redshift
+	id        string
+	createdAt *time.Time
+	tags      map[string]string
+}
+
+func (cs clusterSnapshotSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	input := &redshift.DeleteClusterSnapshotInput{
+		SnapshotIdentifier: aws.String(cs.id),
+	}
+	err := tfresource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := cs.conn.DeleteClusterSnapshotWithContext(ctx, input)
+		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterSnapshotNotFoundFault) {
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Redshift cluster snapshots: %w", err)
+		if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidClusterSnapshotStateFault) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	}, optFns...)
+	if tfresource.TimedOut(err) {
+		_, err = cs.conn.DeleteClusterSnapshotWithContext(ctx, input)
+	}
+
+	return err
+}
+
+func (cs clusterSnapshotSweeper) Metadata(_ context.Context) sweep.ResourceMetadata {
+	return sweep.ResourceMetadata{
+		ID:        cs.id,
+		Name:      cs.id,
+		CreatedAt: cs.createdAt,
+		Tags:      cs.tags,
 	}
-	return nil
 }
 
 func sweepClusters(region string) error {
@@ -2,6 +2,7 @@ package route53
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -13,11 +14,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -34,10 +37,10 @@ var (
 func ResourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceRecordCreate,
-		Read:   resourceRecordRead,
-		Update: resourceRecordUpdate,
-		Delete: resourceRecordDelete,
+		CreateWithoutTimeout: resourceRecordCreate,
+		Read:                 resourceRecordRead,
+		UpdateWithoutTimeout: resourceRecordUpdate,
+		Delete:               resourceRecordDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -241,7 +244,7 @@ func ResourceRecord() *schema.Resource {
 	}
 }
 
-func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Route 53 supports CREATE, DELETE, and UPSERT actions. We use UPSERT, and
 	// AWS dynamically determines if a record should be created or updated.
 	// Amazon Route 53 can update an existing resource record set only when all
@@ -252,7 +255,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		// If neither type nor set_identifier changed we use UPSERT,
 		// for resource update here we simply fall through to
 		// our resource create function.
-		return resourceRecordCreate(d, meta)
+		return resourceRecordCreate(ctx, d, meta)
 	}
 
 	// Otherwise, we delete the existing record and create a new record within
@@ -261,12 +264,12 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
 	var err error
-	zoneRecord, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(zone)})
+	zoneRecord, err := conn.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{Id: aws.String(zone)})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if zoneRecord.HostedZone == nil {
-		return fmt.Errorf("No Route53 Zone found for id (%s)", zone)
+		return diag.Errorf("No Route53 Zone found for id (%s)", zone)
 	}
 
 	// Build the to be deleted record
@@ -371,7 +374,7 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Build the to be created record
 	rec, err := resourceRecordBuildSet(d, aws.StringValue(zoneRecord.HostedZone.Name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Delete the old and create the new records in a single batch. We abuse
@@ -399,9 +402,9 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name: %s\n\n%s",
 		zone, aws.StringValue(rec.Name), input)
 
-	respRaw, err := ChangeRecordSet(conn, input)
+	respRaw, err := ChangeRecordSet(ctx, conn, input)
 	if err != nil {
-		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
+		return diag.Errorf("[ERR]: Error building changeset: %s", err)
 	}
 
 	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
@@ -420,30 +423,30 @@ func resourceRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	err = WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = findRecord(d, meta)
-	return err
+	return diag.FromErr(err)
 }
 
-func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53Conn
 	zone := CleanZoneID(d.Get("zone_id").(string))
 
 	var err error
-	zoneRecord, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(zone)})
+	zoneRecord, err := conn.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{Id: aws.String(zone)})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if zoneRecord.HostedZone == nil {
-		return fmt.Errorf("No Route53 Zone found for id (%s)", zone)
+		return diag.Errorf("No Route53 Zone found for id (%s)", zone)
 	}
 
 	// Build the record
 	rec, err := resourceRecordBuildSet(d, aws.StringValue(zoneRecord.HostedZone.Name))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Protect existing DNS records which might be managed in another way.
@@ -477,9 +480,9 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Creating resource records for zone: %s, name: %s\n\n%s",
 		zone, aws.StringValue(rec.Name), req)
 
-	respRaw, err := ChangeRecordSet(conn, req)
+	respRaw, err := ChangeRecordSet(ctx, conn, req)
 	if err != nil {
-		return fmt.Errorf("[ERR]: Error building changeset: %w", err)
+		return diag.Errorf("[ERR]: Error building changeset: %s", err)
 	}
 
 	changeInfo := respRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
@@ -498,32 +501,23 @@ func resourceRecordCreate(d *schema.ResourceData, meta interface{}) error {
 
 	err = WaitForRecordSetToSync(conn, CleanChangeID(aws.StringValue(changeInfo.Id)))
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	_, err = findRecord(d, meta)
-	return err
+	return diag.FromErr(err)
 }
 
-func ChangeRecordSet(conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (interface{}, error) {
-	var out *route53.ChangeResourceRecordSetsOutput
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.ChangeResourceRecordSets(input)
-		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
-			log.Print("[DEBUG] Hosted Zone not found, retrying...")
-			return resource.RetryableError(err)
+func ChangeRecordSet(ctx context.Context, conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (interface{}, error) {
+	return tfresource.RetryWithPolicy(ctx, 1*time.Minute, retryPolicy(conn), func(ctx context.Context) (interface{}, error) {
+		return conn.ChangeResourceRecordSetsWithContext(ctx, input)
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) || errs.IsCategory(err, errs.CategoryThrottling) {
+			return true, err
 		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		out, err = conn.ChangeResourceRecordSets(input)
-	}
 
-	return out, err
+		return false, err
+	})
 }
 
 func WaitForRecordSetToSync(conn *route53.Route53, requestId string) error {
@@ -0,0 +1,20 @@
+package route53
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// retryPolicy returns the retry policy for Route 53 API calls made with the specified connection.
+// Route 53 allows five API requests per second per account, so all callers using a provider's connection share a single throttle.
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests.
+func retryPolicy(conn *route53.Route53) *tfresource.RetryPolicy {
+	return &tfresource.RetryPolicy{
+		Backoff:        tfresource.ExponentialBackoffWithFullJitter(1*time.Second, 30*time.Second),
+		AttemptTimeout: 1 * time.Minute,
+		Throttle:       tfresource.SharedThrottle(conn, names.Route53, 5, 5),
+	}
+}
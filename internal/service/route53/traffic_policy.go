@@ -89,7 +89,7 @@ func resourceTrafficPolicyCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	log.Printf("[INFO] Creating Route53 Traffic Policy: %s", input)
-	outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEqualsContext(tfresource.WithRetryPolicy(ctx, retryPolicy(conn)), d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		return conn.CreateTrafficPolicyWithContext(ctx, input)
 	}, route53.ErrCodeNoSuchTrafficPolicy)
 
@@ -1,6 +1,7 @@
 package route53_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -503,7 +504,7 @@ func testAccCreateRandomRecordsInZoneIdProvider(providerF func() *schema.Provide
 			},
 		}
 		log.Printf("[DEBUG] Change set: %s\n", *req)
-		resp, err := tfroute53.ChangeRecordSet(conn, req)
+		resp, err := tfroute53.ChangeRecordSet(context.Background(), conn, req)
 		if err != nil {
 			return err
 		}
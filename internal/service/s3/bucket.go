@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -94,7 +95,7 @@ func ResourceBucket() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"grant"},
 				ValidateFunc:  validation.StringInSlice(BucketCannedACL_Values(), false),
-				Deprecated:    "Use the aws_s3_bucket_acl resource instead",
+				Deprecated:    deprecation.ResourceMessage("aws_s3_bucket", "acl"),
 			},
 
 			"grant": {
@@ -102,7 +103,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"acl"},
-				Deprecated:    "Use the aws_s3_bucket_acl resource instead",
+				Deprecated:    deprecation.ResourceMessage("aws_s3_bucket", "grant"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -140,7 +141,7 @@ func ResourceBucket() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				Deprecated:       "Use the aws_s3_bucket_policy resource instead",
+				Deprecated:       deprecation.ResourceMessage("aws_s3_bucket", "policy"),
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
@@ -149,7 +150,7 @@ func ResourceBucket() *schema.Resource {
 				Type:       schema.TypeList,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "Use the aws_s3_bucket_cors_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "cors_rule"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"allowed_headers": {
@@ -185,7 +186,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "Use the aws_s3_bucket_website_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "website"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"index_document": {
@@ -241,12 +242,12 @@ func ResourceBucket() *schema.Resource {
 			"website_endpoint": {
 				Type:       schema.TypeString,
 				Computed:   true,
-				Deprecated: "Use the aws_s3_bucket_website_configuration resource",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "website_endpoint"),
 			},
 			"website_domain": {
 				Type:       schema.TypeString,
 				Computed:   true,
-				Deprecated: "Use the aws_s3_bucket_website_configuration resource",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "website_domain"),
 			},
 
 			"versioning": {
@@ -254,7 +255,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "Use the aws_s3_bucket_versioning resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "versioning"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -276,7 +277,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "Use the aws_s3_bucket_logging resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "logging"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -295,7 +296,7 @@ func ResourceBucket() *schema.Resource {
 				Type:       schema.TypeList,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "Use the aws_s3_bucket_lifecycle_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "lifecycle_rule"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -409,7 +410,7 @@ func ResourceBucket() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				Deprecated:   "Use the aws_s3_bucket_accelerate_configuration resource instead",
+				Deprecated:   deprecation.ResourceMessage("aws_s3_bucket", "acceleration_status"),
 				ValidateFunc: validation.StringInSlice(s3.BucketAccelerateStatus_Values(), false),
 			},
 
@@ -417,7 +418,7 @@ func ResourceBucket() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				Deprecated:   "Use the aws_s3_bucket_request_payment_configuration resource instead",
+				Deprecated:   deprecation.ResourceMessage("aws_s3_bucket", "request_payer"),
 				ValidateFunc: validation.StringInSlice(s3.Payer_Values(), false),
 			},
 
@@ -426,7 +427,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "Use the aws_s3_bucket_replication_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "replication_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role": {
@@ -600,7 +601,7 @@ func ResourceBucket() *schema.Resource {
 				MaxItems:   1,
 				Optional:   true,
 				Computed:   true,
-				Deprecated: "Use the aws_s3_bucket_server_side_encryption_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "server_side_encryption_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
@@ -651,7 +652,7 @@ func ResourceBucket() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				MaxItems:   1,
-				Deprecated: "Use the top-level parameter object_lock_enabled and the aws_s3_bucket_object_lock_configuration resource instead",
+				Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "object_lock_configuration"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"object_lock_enabled": {
@@ -660,13 +661,13 @@ func ResourceBucket() *schema.Resource {
 							ForceNew:      true,
 							ConflictsWith: []string{"object_lock_enabled"},
 							ValidateFunc:  validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
-							Deprecated:    "Use the top-level parameter object_lock_enabled instead",
+							Deprecated:    deprecation.ResourceMessage("aws_s3_bucket", "object_lock_configuration.object_lock_enabled"),
 						},
 
 						"rule": {
 							Type:       schema.TypeList,
 							Optional:   true,
-							Deprecated: "Use the aws_s3_bucket_object_lock_configuration resource instead",
+							Deprecated: deprecation.ResourceMessage("aws_s3_bucket", "object_lock_configuration.rule"),
 							MaxItems:   1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
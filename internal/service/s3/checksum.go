@@ -0,0 +1,383 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+// checksumAttributes are the computed attributes holding an object's additional checksum, keyed by checksum algorithm.
+var checksumAttributes = map[string]string{
+	s3.ChecksumAlgorithmCrc32:  "checksum_crc32",
+	s3.ChecksumAlgorithmCrc32c: "checksum_crc32c",
+	s3.ChecksumAlgorithmSha1:   "checksum_sha1",
+	s3.ChecksumAlgorithmSha256: "checksum_sha256",
+}
+
+// setChecksums sets the computed checksum attributes from an API response's checksum fields.
+func setChecksums(d *schema.ResourceData, vCRC32, vCRC32C, vSHA1, vSHA256 *string) {
+	d.Set(checksumAttributes[s3.ChecksumAlgorithmCrc32], vCRC32)
+	d.Set(checksumAttributes[s3.ChecksumAlgorithmCrc32c], vCRC32C)
+	d.Set(checksumAttributes[s3.ChecksumAlgorithmSha1], vSHA1)
+	d.Set(checksumAttributes[s3.ChecksumAlgorithmSha256], vSHA256)
+}
+
+func newChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+}
+
+// checksumOf returns the raw checksum of the remaining content of a reader, which is then rewound to where it started.
+func checksumOf(algorithm string, r io.ReadSeeker) ([]byte, error) {
+	h, err := newChecksumHash(algorithm)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := r.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.Seek(start, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// checksumsEqual returns whether two base64-encoded checksums are equal.
+// The "-<number of parts>" suffix of a multipart upload's checksum is ignored
+// as S3 doesn't include it in all responses.
+func checksumsEqual(a, b string) bool {
+	a, _, _ = strings.Cut(a, "-")
+	b, _, _ = strings.Cut(b, "-")
+
+	return a == b
+}
+
+// configuredObjectChecksum returns the additional checksum S3 computes for the object content configured by
+// source, content or content_base64, as it would be uploaded by resourceObjectUpload.
+func configuredObjectChecksum(d interface {
+	GetOk(string) (interface{}, bool)
+}, algorithm string) (string, error) {
+	var body io.ReadSeeker
+	var size int64
+
+	if v, ok := d.GetOk("source"); ok {
+		path, err := homedir.Expand(v.(string))
+
+		if err != nil {
+			return "", fmt.Errorf("expanding homedir in source (%s): %w", v.(string), err)
+		}
+
+		file, err := os.Open(path)
+
+		if err != nil {
+			return "", fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		defer file.Close()
+
+		fi, err := file.Stat()
+
+		if err != nil {
+			return "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+		}
+
+		body, size = file, fi.Size()
+	} else if v, ok := d.GetOk("content"); ok {
+		r := bytes.NewReader([]byte(v.(string)))
+		body, size = r, r.Size()
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return "", fmt.Errorf("decoding content_base64: %w", err)
+		}
+
+		r := bytes.NewReader(content)
+		body, size = r, r.Size()
+	} else {
+		body = bytes.NewReader([]byte{})
+	}
+
+	return objectChecksum(algorithm, body, size)
+}
+
+// uploadPartSize returns the part size the s3manager uploader uses for an object of the specified size.
+func uploadPartSize(size int64) int64 {
+	partSize := int64(s3manager.DefaultUploadPartSize)
+
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	return partSize
+}
+
+// objectChecksum returns the base64-encoded additional checksum S3 computes for an object uploaded by the s3manager uploader.
+// Objects uploaded in multiple parts have a checksum of the concatenated checksums of each part, followed by "-<number of parts>".
+func objectChecksum(algorithm string, body io.ReadSeeker, size int64) (string, error) {
+	partSize := uploadPartSize(size)
+
+	if size <= partSize {
+		v, err := checksumOf(algorithm, body)
+
+		if err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(v), nil
+	}
+
+	start, err := body.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return "", err
+	}
+
+	h, err := newChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	nParts := 0
+
+	for offset := int64(0); offset < size; offset += partSize {
+		part, err := newChecksumHash(algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := io.CopyN(part, body, partSize); err != nil && err != io.EOF {
+			return "", err
+		}
+
+		h.Write(part.Sum(nil))
+		nParts++
+	}
+
+	if _, err := body.Seek(start, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), nParts), nil
+}
+
+// checksumUpload adds an additional checksum to each request made by the s3manager uploader for one object
+// and records the object checksum returned by S3.
+// The uploader only passes a checksum algorithm to CreateMultipartUpload, so the checksum of each part is computed
+// and added to its UploadPart request and to the CompleteMultipartUpload request.
+type checksumUpload struct {
+	algorithm string
+
+	mu       sync.Mutex
+	parts    map[int64]string // Base64-encoded checksum by part number.
+	returned string
+}
+
+func newChecksumUpload(algorithm string) *checksumUpload {
+	return &checksumUpload{
+		algorithm: algorithm,
+		parts:     make(map[int64]string),
+	}
+}
+
+// requestOption is a request.Option adding the checksum handlers to a request.
+func (u *checksumUpload) requestOption(r *request.Request) {
+	r.Handlers.Build.PushFront(u.build)
+	r.Handlers.Complete.PushBack(u.complete)
+}
+
+func (u *checksumUpload) build(r *request.Request) {
+	switch params := r.Params.(type) {
+	case *s3.PutObjectInput:
+		if params.Body == nil {
+			return
+		}
+
+		v, err := checksumOf(u.algorithm, params.Body)
+
+		if err != nil {
+			r.Error = fmt.Errorf("computing %s checksum: %w", u.algorithm, err)
+			return
+		}
+
+		setInputChecksum(params, u.algorithm, base64.StdEncoding.EncodeToString(v))
+	case *s3.UploadPartInput:
+		v, err := checksumOf(u.algorithm, params.Body)
+
+		if err != nil {
+			r.Error = fmt.Errorf("computing %s checksum of part %d: %w", u.algorithm, aws.Int64Value(params.PartNumber), err)
+			return
+		}
+
+		checksum := base64.StdEncoding.EncodeToString(v)
+		setInputChecksum(params, u.algorithm, checksum)
+
+		u.mu.Lock()
+		u.parts[aws.Int64Value(params.PartNumber)] = checksum
+		u.mu.Unlock()
+	case *s3.CompleteMultipartUploadInput:
+		if params.MultipartUpload == nil {
+			return
+		}
+
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		for _, part := range params.MultipartUpload.Parts {
+			setInputChecksum(part, u.algorithm, u.parts[aws.Int64Value(part.PartNumber)])
+		}
+	}
+}
+
+func (u *checksumUpload) complete(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+
+	var returned string
+
+	switch output := r.Data.(type) {
+	case *s3.PutObjectOutput:
+		returned = checksumField(u.algorithm, output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256)
+	case *s3.CompleteMultipartUploadOutput:
+		returned = checksumField(u.algorithm, output.ChecksumCRC32, output.ChecksumCRC32C, output.ChecksumSHA1, output.ChecksumSHA256)
+	default:
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.returned = returned
+}
+
+// Returned returns the object checksum returned by S3, or "" if there was none.
+func (u *checksumUpload) Returned() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.returned
+}
+
+// setInputChecksum sets the checksum field for an algorithm of a PutObject, UploadPart or CompletedPart structure.
+func setInputChecksum(v interface{}, algorithm, checksum string) {
+	switch v := v.(type) {
+	case *s3.PutObjectInput:
+		v.ChecksumAlgorithm = aws.String(algorithm)
+		v.ChecksumCRC32, v.ChecksumCRC32C, v.ChecksumSHA1, v.ChecksumSHA256 = checksumFields(algorithm, checksum)
+	case *s3.UploadPartInput:
+		v.ChecksumAlgorithm = aws.String(algorithm)
+		v.ChecksumCRC32, v.ChecksumCRC32C, v.ChecksumSHA1, v.ChecksumSHA256 = checksumFields(algorithm, checksum)
+	case *s3.CompletedPart:
+		v.ChecksumCRC32, v.ChecksumCRC32C, v.ChecksumSHA1, v.ChecksumSHA256 = checksumFields(algorithm, checksum)
+	}
+}
+
+func checksumFields(algorithm, checksum string) (vCRC32, vCRC32C, vSHA1, vSHA256 *string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		vCRC32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		vCRC32C = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		vSHA1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		vSHA256 = aws.String(checksum)
+	}
+
+	return
+}
+
+// checksumField returns the checksum for an algorithm from an API response's checksum fields.
+func checksumField(algorithm string, vCRC32, vCRC32C, vSHA1, vSHA256 *string) string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return aws.StringValue(vCRC32)
+	case s3.ChecksumAlgorithmCrc32c:
+		return aws.StringValue(vCRC32C)
+	case s3.ChecksumAlgorithmSha1:
+		return aws.StringValue(vSHA1)
+	case s3.ChecksumAlgorithmSha256:
+		return aws.StringValue(vSHA256)
+	}
+
+	return ""
+}
+
+// uploadWithChecksum uploads an object with the s3manager uploader, adding an additional checksum computed with the specified algorithm,
+// and verifies the object checksum returned by S3.
+// Returns the object's base64-encoded checksum.
+func uploadWithChecksum(uploader *s3manager.Uploader, input *s3manager.UploadInput, algorithm string) (*s3manager.UploadOutput, string, error) {
+	body, ok := input.Body.(io.ReadSeeker)
+
+	if !ok {
+		return nil, "", fmt.Errorf("computing %s checksum: body is not seekable", algorithm)
+	}
+
+	size, err := aws.SeekerLen(body)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	expected, err := objectChecksum(algorithm, body, size)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("computing %s checksum: %w", algorithm, err)
+	}
+
+	upload := newChecksumUpload(algorithm)
+	input.ChecksumAlgorithm = aws.String(algorithm)
+
+	output, err := uploader.Upload(input, func(u *s3manager.Uploader) {
+		u.PartSize = uploadPartSize(size)
+		u.RequestOptions = append(u.RequestOptions, upload.requestOption)
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if returned := upload.Returned(); returned != "" && !checksumsEqual(returned, expected) {
+		return output, "", fmt.Errorf("%s checksum returned by S3 (%s) does not match the source (%s)", algorithm, returned, expected)
+	}
+
+	return output, expected, nil
+}
@@ -0,0 +1,170 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	testCases := map[string]string{
+		s3.ChecksumAlgorithmCrc32:  "DUoRhQ==",
+		s3.ChecksumAlgorithmCrc32c: "yZRlqg==",
+		s3.ChecksumAlgorithmSha1:   "Kq5sNclPz7QV2+lfQIuc6R7oRu0=",
+		s3.ChecksumAlgorithmSha256: "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek=",
+	}
+
+	for algorithm, expected := range testCases {
+		body := bytes.NewReader([]byte("hello world"))
+
+		got, err := objectChecksum(algorithm, body, body.Size())
+
+		if err != nil {
+			t.Fatalf("%s: %s", algorithm, err)
+		}
+
+		if got != expected {
+			t.Errorf("%s: got %s, expected %s", algorithm, got, expected)
+		}
+
+		if body.Len() != int(body.Size()) {
+			t.Errorf("%s: body not rewound", algorithm)
+		}
+	}
+}
+
+func TestObjectChecksum_multipart(t *testing.T) {
+	const partSize = int(s3manager.DefaultUploadPartSize)
+	data := bytes.Repeat([]byte("a"), partSize+1024)
+	part1 := sha256.Sum256(data[:partSize])
+	part2 := sha256.Sum256(data[partSize:])
+	composite := sha256.Sum256(append(part1[:], part2[:]...))
+	expected := base64.StdEncoding.EncodeToString(composite[:]) + "-2"
+
+	got, err := objectChecksum(s3.ChecksumAlgorithmSha256, bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestChecksumsEqual(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"DUoRhQ==", "DUoRhQ==", true},
+		{"DUoRhQ==", "yZRlqg==", false},
+		{"DUoRhQ==-2", "DUoRhQ==", true},
+		{"DUoRhQ==-2", "DUoRhQ==-2", true},
+		{"DUoRhQ==-2", "yZRlqg==-2", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := checksumsEqual(testCase.a, testCase.b); got != testCase.expected {
+			t.Errorf("checksumsEqual(%q, %q) = %t, expected %t", testCase.a, testCase.b, got, testCase.expected)
+		}
+	}
+}
+
+func TestChecksumUpload(t *testing.T) {
+	u := newChecksumUpload(s3.ChecksumAlgorithmCrc32)
+
+	for i, data := range []string{"hello", " world"} {
+		input := &s3.UploadPartInput{
+			Body:       bytes.NewReader([]byte(data)),
+			PartNumber: aws.Int64(int64(i + 1)),
+		}
+
+		r := &request.Request{Params: input}
+		u.build(r)
+
+		if r.Error != nil {
+			t.Fatal(r.Error)
+		}
+
+		if got := aws.StringValue(input.ChecksumCRC32); got == "" {
+			t.Errorf("part %d: no checksum", i+1)
+		}
+
+		if got, expected := aws.StringValue(input.ChecksumAlgorithm), s3.ChecksumAlgorithmCrc32; got != expected {
+			t.Errorf("part %d: got checksum algorithm %s, expected %s", i+1, got, expected)
+		}
+	}
+
+	complete := &s3.CompleteMultipartUploadInput{
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: []*s3.CompletedPart{
+				{PartNumber: aws.Int64(2)},
+				{PartNumber: aws.Int64(1)},
+			},
+		},
+	}
+
+	u.build(&request.Request{Params: complete})
+
+	if got, expected := aws.StringValue(complete.MultipartUpload.Parts[1].ChecksumCRC32), u.parts[1]; got != expected {
+		t.Errorf("part 1: got checksum %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(complete.MultipartUpload.Parts[0].ChecksumCRC32), u.parts[2]; got != expected {
+		t.Errorf("part 2: got checksum %s, expected %s", got, expected)
+	}
+
+	u.complete(&request.Request{Data: &s3.CompleteMultipartUploadOutput{ChecksumCRC32: aws.String("abc-2")}})
+
+	if got, expected := u.Returned(), "abc-2"; got != expected {
+		t.Errorf("got returned checksum %s, expected %s", got, expected)
+	}
+}
+
+func TestChecksumField(t *testing.T) {
+	if got, expected := checksumField(s3.ChecksumAlgorithmSha1, aws.String("crc32"), nil, aws.String("sha1"), nil), "sha1"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got := checksumField("", aws.String("crc32"), nil, nil, nil); got != "" {
+		t.Errorf("got %s, expected no checksum", got)
+	}
+}
+
+func TestConfiguredObjectChecksum(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "source")
+
+	if err := os.WriteFile(source, []byte("hello world"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]map[string]interface{}{
+		"content":        {"content": "hello world"},
+		"content_base64": {"content_base64": base64.StdEncoding.EncodeToString([]byte("hello world"))},
+		"source":         {"source": source},
+	}
+
+	for name, raw := range testCases {
+		d := schema.TestResourceDataRaw(t, ResourceObject().Schema, raw)
+
+		got, err := configuredObjectChecksum(d, s3.ChecksumAlgorithmSha256)
+
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+
+		if expected := "uU0nuZNNPgilLlLX2n2r+sSE7+N6U4DukIj3rOLvzek="; got != expected {
+			t.Errorf("%s: got %s, expected %s", name, got, expected)
+		}
+	}
+}
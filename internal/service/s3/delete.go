@@ -3,6 +3,9 @@ package s3
 import (
 	"context"
 	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -11,77 +14,138 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 )
 
+// emptyBucketConcurrency is the maximum number of concurrent DeleteObjects requests made when emptying a bucket.
+const emptyBucketConcurrency = 10
+
 // EmptyBucket empties the specified S3 bucket by deleting all object versions and delete markers.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
 // Returns the number of objects deleted.
 func EmptyBucket(ctx context.Context, conn *s3.S3, bucket string, force bool) (int64, error) {
-	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
-		return deletePageOfObjectVersions(ctx, conn, bucket, force, page)
-	})
+	return EmptyBucketPrefix(ctx, conn, bucket, "", force)
+}
 
-	if err != nil {
-		return nObjects, err
-	}
+// EmptyBucketPrefix deletes all versions and delete markers of the objects in the specified S3 bucket whose keys begin with `prefix`,
+// or of all objects if `prefix` is empty.
+// Versions and delete markers are deleted in a single pass over the bucket's object versions, with pages of versions
+// deleted concurrently while listing continues. As deleted versions are not listed again, a call that is interrupted,
+// e.g. by a timeout, can be repeated to resume where it left off.
+// If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
+// an attempt is made to remove any S3 Object Lock legal holds.
+// Returns the number of objects deleted.
+func EmptyBucketPrefix(ctx context.Context, conn *s3.S3, bucket, prefix string, force bool) (int64, error) {
+	var nObjects, nListed int64
+	var failed int32
+	var interrupted bool
+	var deleteErrs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
 
-	n, err := forEachObjectVersionsPage(ctx, conn, bucket, deletePageOfDeleteMarkers)
-	nObjects += n
+	batches := make(chan []*s3.ObjectIdentifier)
 
-	return nObjects, err
-}
+	for i := 0; i < emptyBucketConcurrency; i++ {
+		wg.Add(1)
 
-// forEachObjectVersionsPage calls the specified function for each page returned from the S3 ListObjectVersionsPages API.
-func forEachObjectVersionsPage(ctx context.Context, conn *s3.S3, bucket string, fn func(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
-	var nObjects int64
+		go func() {
+			defer wg.Done()
+
+			for batch := range batches {
+				n, err := deleteObjectVersions(ctx, conn, bucket, force, batch)
+				atomic.AddInt64(&nObjects, n)
+
+				if err != nil {
+					atomic.StoreInt32(&failed, 1)
+
+					mu.Lock()
+					deleteErrs = multierror.Append(deleteErrs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
 
 	input := &s3.ListObjectVersionsInput{
 		Bucket: aws.String(bucket),
 	}
-	var lastErr error
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
 
 	err := conn.ListObjectVersionsPagesWithContext(ctx, input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		n, err := fn(ctx, conn, bucket, page)
-		nObjects += n
-
-		if err != nil {
-			lastErr = err
-
-			return false
+		for _, batch := range objectVersionBatches(page) {
+			select {
+			case batches <- batch:
+				nListed += int64(len(batch))
+			case <-ctx.Done():
+				interrupted = true
+				return false
+			}
 		}
 
-		return !lastPage
+		log.Printf("[INFO] Emptying S3 Bucket (%s): %d object versions and delete markers listed, %d deleted", bucket, nListed, atomic.LoadInt64(&nObjects))
+
+		// Stop listing after the first error, letting in-flight deletions complete.
+		return !lastPage && atomic.LoadInt32(&failed) == 0
 	})
 
-	if err != nil {
-		return nObjects, fmt.Errorf("listing S3 Bucket (%s) object versions: %w", bucket, err)
-	}
+	close(batches)
+	wg.Wait()
 
-	if lastErr != nil {
-		return nObjects, lastErr
+	if err != nil {
+		deleteErrs = multierror.Append(deleteErrs, fmt.Errorf("listing S3 Bucket (%s) object versions: %w", bucket, err))
+	} else if interrupted {
+		// Listing stops without error when the context is done while queuing deletions.
+		deleteErrs = multierror.Append(deleteErrs, fmt.Errorf("emptying S3 Bucket (%s): %w", bucket, ctx.Err()))
 	}
 
-	return nObjects, nil
+	return nObjects, deleteErrs.ErrorOrNil()
 }
 
-// deletePageOfObjectVersions deletes a page (<= 1000) of S3 object versions.
-// If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
-// an attempt is made to remove any S3 Object Lock legal holds.
-// Returns the number of objects deleted.
-func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string, force bool, page *s3.ListObjectVersionsOutput) (int64, error) {
-	var nObjects int64
+// objectVersionBatches returns a page's object versions and delete markers in batches of up to 1000,
+// the maximum number of objects in a DeleteObjects request.
+func objectVersionBatches(page *s3.ListObjectVersionsOutput) [][]*s3.ObjectIdentifier {
+	objects := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
 
-	toDelete := make([]*s3.ObjectIdentifier, 0, len(page.Versions))
 	for _, v := range page.Versions {
-		toDelete = append(toDelete, &s3.ObjectIdentifier{
+		objects = append(objects, &s3.ObjectIdentifier{
 			Key:       v.Key,
 			VersionId: v.VersionId,
 		})
 	}
 
+	for _, v := range page.DeleteMarkers {
+		objects = append(objects, &s3.ObjectIdentifier{
+			Key:       v.Key,
+			VersionId: v.VersionId,
+		})
+	}
+
+	var batches [][]*s3.ObjectIdentifier
+
+	for len(objects) > deleteObjectsMaxKeys {
+		batches = append(batches, objects[:deleteObjectsMaxKeys])
+		objects = objects[deleteObjectsMaxKeys:]
+	}
+
+	if len(objects) > 0 {
+		batches = append(batches, objects)
+	}
+
+	return batches
+}
+
+// deleteObjectVersions deletes a batch (<= 1000) of S3 object versions and delete markers.
+// If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
+// an attempt is made to remove any S3 Object Lock legal holds.
+// Returns the number of objects deleted.
+func deleteObjectVersions(ctx context.Context, conn *s3.S3, bucket string, force bool, toDelete []*s3.ObjectIdentifier) (int64, error) {
+	var nObjects int64
+
 	if nObjects = int64(len(toDelete)); nObjects == 0 {
 		return nObjects, nil
 	}
@@ -105,7 +169,7 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string,
 	}
 
 	if err != nil {
-		return nObjects, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		return 0, fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
 	}
 
 	nObjects -= int64(len(output.Errors))
@@ -140,9 +204,10 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string,
 			} else {
 				// Attempt to delete the object once the legal hold has been removed.
 				_, err := conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
-					Bucket:    aws.String(bucket),
-					Key:       aws.String(key),
-					VersionId: aws.String(versionID),
+					Bucket:                    aws.String(bucket),
+					BypassGovernanceRetention: aws.Bool(true),
+					Key:                       aws.String(key),
+					VersionId:                 aws.String(versionID),
 				})
 
 				if err != nil {
@@ -163,56 +228,6 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.S3, bucket string,
 	return nObjects, nil
 }
 
-// deletePageOfDeleteMarkers deletes a page (<= 1000) of S3 object delete markers.
-// Returns the number of delete markers deleted.
-func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.S3, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
-	var nObjects int64
-
-	toDelete := make([]*s3.ObjectIdentifier, 0, len(page.Versions))
-	for _, v := range page.DeleteMarkers {
-		toDelete = append(toDelete, &s3.ObjectIdentifier{
-			Key:       v.Key,
-			VersionId: v.VersionId,
-		})
-	}
-
-	if nObjects = int64(len(toDelete)); nObjects == 0 {
-		return nObjects, nil
-	}
-
-	input := &s3.DeleteObjectsInput{
-		Bucket: aws.String(bucket),
-		Delete: &s3.Delete{
-			Objects: toDelete,
-			Quiet:   aws.Bool(true), // Only report errors.
-		},
-	}
-
-	output, err := conn.DeleteObjectsWithContext(ctx, input)
-
-	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
-		return nObjects, nil
-	}
-
-	if err != nil {
-		return nObjects, fmt.Errorf("deleting S3 Bucket (%s) delete markers: %w", bucket, err)
-	}
-
-	nObjects -= int64(len(output.Errors))
-
-	var deleteErrs *multierror.Error
-
-	for _, v := range output.Errors {
-		deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
-	}
-
-	if err := deleteErrs.ErrorOrNil(); err != nil {
-		return nObjects, fmt.Errorf("deleting S3 Bucket (%s) delete markers: %w", bucket, err)
-	}
-
-	return nObjects, nil
-}
-
 func newObjectVersionError(key, versionID string, err error) error {
 	if err == nil {
 		return nil
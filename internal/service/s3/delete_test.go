@@ -1,22 +1,29 @@
-package s3_test
+package s3
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"sync/atomic"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
-	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 )
 
 // AWS_REGION=us-west-2 go test -v ./internal/service/s3 -run=TestEmptyBucket -b ewbankkit-test-empty-bucket-001 -f
+// AWS_REGION=us-west-2 go test -v ./internal/service/s3 -run=TestEmptyBucketPrefix -b ewbankkit-test-empty-bucket-001 -p logs/
 
-var bucket = flag.String("b", "", "bucket")
-var force = flag.Bool("f", false, "force")
+var emptyBucketName = flag.String("b", "", "bucket")
+var emptyBucketForce = flag.Bool("f", false, "force")
+var emptyBucketPrefix = flag.String("p", "", "key prefix")
 
 func TestEmptyBucket(t *testing.T) {
-	if *bucket == "" {
+	if *emptyBucketName == "" {
 		t.Skip("bucket not specified")
 	}
 
@@ -24,28 +31,302 @@ func TestEmptyBucket(t *testing.T) {
 	svc := s3.New(sess)
 	ctx := context.Background()
 
-	n, err := tfs3.EmptyBucket(ctx, svc, *bucket, *force)
+	n, err := EmptyBucket(ctx, svc, *emptyBucketName, *emptyBucketForce)
 
 	if err != nil {
-		t.Fatalf("error emptying S3 bucket (%s): %s", *bucket, err)
+		t.Fatalf("error emptying S3 bucket (%s): %s", *emptyBucketName, err)
+	}
+
+	t.Logf("%d S3 objects deleted", n)
+}
+
+func TestEmptyBucketPrefix(t *testing.T) {
+	if *emptyBucketName == "" || *emptyBucketPrefix == "" {
+		t.Skip("bucket or key prefix not specified")
+	}
+
+	sess := session.Must(session.NewSession())
+	svc := s3.New(sess)
+	ctx := context.Background()
+
+	n, err := EmptyBucketPrefix(ctx, svc, *emptyBucketName, *emptyBucketPrefix, *emptyBucketForce)
+
+	if err != nil {
+		t.Fatalf("error deleting S3 objects (%s) from bucket (%s): %s", *emptyBucketPrefix, *emptyBucketName, err)
 	}
 
 	t.Logf("%d S3 objects deleted", n)
 }
 
 func TestDeleteAllObjectVersions(t *testing.T) {
-	if *bucket == "" {
+	if *emptyBucketName == "" {
 		t.Skip("bucket not specified")
 	}
 
 	sess := session.Must(session.NewSession())
 	svc := s3.New(sess)
 
-	n, err := tfs3.DeleteAllObjectVersions(svc, *bucket, "", *force, false)
+	n, err := DeleteAllObjectVersions(svc, *emptyBucketName, "", *emptyBucketForce, false)
 
 	if err != nil {
-		t.Fatalf("error emptying S3 bucket (%s): %s", *bucket, err)
+		t.Fatalf("error emptying S3 bucket (%s): %s", *emptyBucketName, err)
 	}
 
 	t.Logf("%d S3 objects deleted", n)
 }
+
+func TestObjectVersionBatches(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Versions      int
+		DeleteMarkers int
+		Expected      []int
+	}{
+		{
+			TestName: "empty",
+		},
+		{
+			TestName:      "single batch",
+			Versions:      600,
+			DeleteMarkers: 400,
+			Expected:      []int{1000},
+		},
+		{
+			TestName:      "split",
+			Versions:      1000,
+			DeleteMarkers: 1,
+			Expected:      []int{1000, 1},
+		},
+		{
+			TestName: "multiple batches",
+			Versions: 2500,
+			Expected: []int{1000, 1000, 500},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			page := &s3.ListObjectVersionsOutput{}
+
+			for i := 0; i < testCase.Versions; i++ {
+				page.Versions = append(page.Versions, &s3.ObjectVersion{Key: aws.String(fmt.Sprintf("v%d", i)), VersionId: aws.String("1")})
+			}
+
+			for i := 0; i < testCase.DeleteMarkers; i++ {
+				page.DeleteMarkers = append(page.DeleteMarkers, &s3.DeleteMarkerEntry{Key: aws.String(fmt.Sprintf("d%d", i)), VersionId: aws.String("2")})
+			}
+
+			batches := objectVersionBatches(page)
+
+			if got, expected := len(batches), len(testCase.Expected); got != expected {
+				t.Fatalf("got %d batches, expected %d", got, expected)
+			}
+
+			keys := make(map[string]bool)
+
+			for i, batch := range batches {
+				if got, expected := len(batch), testCase.Expected[i]; got != expected {
+					t.Errorf("batch %d: got %d objects, expected %d", i, got, expected)
+				}
+
+				for _, v := range batch {
+					keys[aws.StringValue(v.Key)] = true
+				}
+			}
+
+			if got, expected := len(keys), testCase.Versions+testCase.DeleteMarkers; got != expected {
+				t.Errorf("got %d distinct keys, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestDeleteObjectVersions(t *testing.T) {
+	toDelete := []*s3.ObjectIdentifier{
+		{Key: aws.String("a"), VersionId: aws.String("1")},
+		{Key: aws.String("b"), VersionId: aws.String("1")},
+		{Key: aws.String("c"), VersionId: aws.String("1")},
+	}
+
+	testCases := []struct {
+		TestName    string
+		Output      *s3.DeleteObjectsOutput
+		Error       error
+		Expected    int64
+		ExpectError bool
+	}{
+		{
+			TestName: "deleted",
+			Output:   &s3.DeleteObjectsOutput{},
+			Expected: 3,
+		},
+		{
+			TestName: "not found",
+			Output: &s3.DeleteObjectsOutput{
+				Errors: []*s3.Error{{Code: aws.String(s3.ErrCodeNoSuchKey), Key: aws.String("a"), VersionId: aws.String("1")}},
+			},
+			Expected: 2,
+		},
+		{
+			TestName: "partial failure",
+			Output: &s3.DeleteObjectsOutput{
+				Errors: []*s3.Error{{Code: aws.String("InternalError"), Key: aws.String("b"), VersionId: aws.String("1")}},
+			},
+			Expected:    2,
+			ExpectError: true,
+		},
+		{
+			TestName:    "request failure",
+			Error:       awserr.New("InternalError", "We encountered an internal error", nil),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			conn := newMockS3Conn(t, func(r *request.Request) {
+				if r.Operation.Name != "DeleteObjects" {
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+					return
+				}
+
+				if testCase.Error != nil {
+					r.Error = testCase.Error
+					return
+				}
+
+				*r.Data.(*s3.DeleteObjectsOutput) = *testCase.Output
+			})
+
+			n, err := deleteObjectVersions(context.Background(), conn, "test", false, toDelete)
+
+			if got, expected := err != nil, testCase.ExpectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+
+			if got, expected := n, testCase.Expected; got != expected {
+				t.Errorf("got %d objects deleted, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestEmptyBucketPrefixInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var nListed int32
+
+	conn := newMockS3Conn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "ListObjectVersions":
+			// The first page keeps every deletion worker busy until the context is done,
+			// which happens while the second page is being queued for deletion.
+			if atomic.AddInt32(&nListed, 1) > 1 {
+				cancel()
+			}
+
+			*r.Data.(*s3.ListObjectVersionsOutput) = *mockObjectVersionsPage(r, 100, emptyBucketConcurrency*deleteObjectsMaxKeys)
+		case "DeleteObjects":
+			<-r.Context().Done()
+			r.Error = r.Context().Err()
+		}
+	})
+
+	n, err := EmptyBucketPrefix(ctx, conn, "test", "", false)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, expected %s", err, context.Canceled)
+	}
+
+	if n != 0 {
+		t.Errorf("got %d objects deleted, expected 0", n)
+	}
+
+	if got := atomic.LoadInt32(&nListed); got != 2 {
+		t.Errorf("got %d pages listed, expected 2", got)
+	}
+}
+
+func TestEmptyBucketPrefixStopsOnFirstError(t *testing.T) {
+	const nPages = 100
+
+	var nListed, nDeleteRequests int32
+
+	conn := newMockS3Conn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "ListObjectVersions":
+			atomic.AddInt32(&nListed, 1)
+
+			*r.Data.(*s3.ListObjectVersionsOutput) = *mockObjectVersionsPage(r, nPages, 1)
+		case "DeleteObjects":
+			atomic.AddInt32(&nDeleteRequests, 1)
+
+			r.Error = awserr.New("InternalError", "We encountered an internal error", nil)
+		}
+	})
+
+	n, err := EmptyBucketPrefix(context.Background(), conn, "test", "", false)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if n != 0 {
+		t.Errorf("got %d objects deleted, expected 0", n)
+	}
+
+	// Listing stops once a deletion has failed. In-flight deletions may still complete.
+	if got := atomic.LoadInt32(&nListed); got >= nPages {
+		t.Errorf("got %d pages listed, expected listing to stop before the last page", got)
+	}
+
+	if got, listed := atomic.LoadInt32(&nDeleteRequests), atomic.LoadInt32(&nListed); got != listed {
+		t.Errorf("got %d DeleteObjects requests, expected one per listed page (%d)", got, listed)
+	}
+}
+
+// newMockS3Conn returns an S3 client that makes no requests. Each request's output or error is set by the specified handler.
+func newMockS3Conn(t *testing.T, handler func(*request.Request)) *s3.S3 {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String("us-west-2")})
+
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := s3.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(handler)
+
+	return conn
+}
+
+// mockObjectVersionsPage returns the page of object versions following the request's key marker,
+// out of the specified number of pages.
+func mockObjectVersionsPage(r *request.Request, nPages, nVersions int) *s3.ListObjectVersionsOutput {
+	var i int
+
+	if v := aws.StringValue(r.Params.(*s3.ListObjectVersionsInput).KeyMarker); v != "" {
+		fmt.Sscanf(v, "key%d", &i)
+		i++
+	}
+
+	key := fmt.Sprintf("key%d", i)
+	page := &s3.ListObjectVersionsOutput{}
+
+	for j := 0; j < nVersions; j++ {
+		page.Versions = append(page.Versions, &s3.ObjectVersion{Key: aws.String(key), VersionId: aws.String(fmt.Sprintf("%d", j))})
+	}
+
+	if i < nPages-1 {
+		page.IsTruncated = aws.Bool(true)
+		page.NextKeyMarker = aws.String(key)
+		page.NextVersionIdMarker = aws.String("1")
+	}
+
+	return page
+}
@@ -0,0 +1,294 @@
+package s3
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// localFile is a file in a directory synchronized with S3.
+type localFile struct {
+	Path        string // Absolute path.
+	Hash        string // Hex-encoded SHA-256 of the file's content.
+	ContentType string
+}
+
+// localDirectory returns the files in a directory, and its subdirectories, which match any of the include globs
+// (or all files if there are none) and none of the exclude globs, keyed by S3 object key.
+// Globs are matched against the slash-separated path relative to dir.
+func localDirectory(dir, keyPrefix string, include, exclude []string, contentTypes map[string]string, defaultContentType string) (map[string]localFile, error) {
+	keyPrefix = directoryKeyPrefix(keyPrefix)
+
+	includeRegexps, err := globRegexps(include)
+
+	if err != nil {
+		return nil, err
+	}
+
+	excludeRegexps, err := globRegexps(exclude)
+
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]localFile)
+
+	err = filepath.WalkDir(dir, func(filename string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !e.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, filename)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includeRegexps) > 0 && !matchAny(includeRegexps, rel) {
+			return nil
+		}
+
+		if matchAny(excludeRegexps, rel) {
+			return nil
+		}
+
+		hash, err := fileSHA256(filename)
+
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+rel] = localFile{
+			Path:        filename,
+			Hash:        hash,
+			ContentType: contentTypeByExtension(rel, contentTypes, defaultContentType),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading directory (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// directoryKeyPrefix returns a key prefix ending in "/", so that e.g. "site" covers "site/index.html"
+// but not the sibling keys "sitemap.xml" or "site-backup/index.html".
+func directoryKeyPrefix(keyPrefix string) string {
+	if keyPrefix == "" || strings.HasSuffix(keyPrefix, "/") {
+		return keyPrefix
+	}
+
+	return keyPrefix + "/"
+}
+
+func fileSHA256(filename string) (string, error) {
+	file, err := os.Open(filename)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func matchAny(regexps []*regexp.Regexp, s string) bool {
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func globRegexps(globs []string) ([]*regexp.Regexp, error) {
+	var regexps []*regexp.Regexp
+
+	for _, glob := range globs {
+		re, err := globRegexp(glob)
+
+		if err != nil {
+			return nil, err
+		}
+
+		regexps = append(regexps, re)
+	}
+
+	return regexps, nil
+}
+
+// globRegexp compiles a glob, as accepted by Terraform's fileset function, into a regular expression.
+// "*" matches any sequence of characters other than "/", "**" matches any sequence of characters,
+// "**/" matches zero or more directories, "?" matches any single character other than "/",
+// "[...]" matches a character class and "{a,b}" matches any of the comma-separated alternatives.
+func globRegexp(glob string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	depth := 0 // Nesting depth of {...}.
+
+	b.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			switch {
+			case strings.HasPrefix(glob[i:], "**/"):
+				b.WriteString("(?:.*/)?")
+				i += 2
+			case strings.HasPrefix(glob[i:], "**"):
+				b.WriteString(".*")
+				i++
+			default:
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob (%s): unterminated character class", glob)
+			}
+
+			class := glob[i+1 : i+1+end]
+
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		case '{':
+			b.WriteString("(?:")
+			depth++
+		case '}':
+			if depth == 0 {
+				return nil, fmt.Errorf("invalid glob (%s): unexpected }", glob)
+			}
+
+			b.WriteString(")")
+			depth--
+		case ',':
+			if depth > 0 {
+				b.WriteString("|")
+			} else {
+				b.WriteString(",")
+			}
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("invalid glob (%s): unterminated {", glob)
+	}
+
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob (%s): %w", glob, err)
+	}
+
+	return re, nil
+}
+
+func validGlob(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := globRegexp(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// contentTypeByExtension returns the Content-Type of an object from its file extension.
+// Extensions in overrides, e.g. ".md", take precedence over the built-in table.
+// The built-in table is used rather than the operating system's so that plans are the same on every machine.
+func contentTypeByExtension(filename string, overrides map[string]string, defaultContentType string) string {
+	ext := strings.ToLower(path.Ext(filename))
+
+	if ext == "" {
+		return defaultContentType
+	}
+
+	for k, v := range overrides {
+		if strings.ToLower(k) == ext {
+			return v
+		}
+	}
+
+	if v, ok := contentTypes[ext]; ok {
+		return v
+	}
+
+	return defaultContentType
+}
+
+var contentTypes = map[string]string{
+	".aac":         "audio/aac",
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "application/xml",
+	".yaml":        "application/yaml",
+	".yml":         "application/yaml",
+	".zip":         "application/zip",
+}
@@ -0,0 +1,647 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directoryObjectsDefaultConcurrency = 10
+
+	// deleteObjectsMaxKeys is the maximum number of keys in an S3 DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
+// ResourceDirectoryObjects synchronizes the files in a local directory with the objects under a key prefix in an S3 bucket.
+//
+// State records each object's content hash, Content-Type and the ETag S3 returned when it was uploaded.
+// Changes are detected by comparing content hashes with the local files and ETags with those S3 currently reports,
+// never ETags with local MD5s, so that multipart uploads and KMS-encrypted objects don't cause perpetual diffs.
+func ResourceDirectoryObjects() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryObjectsCreate,
+		ReadContext:   resourceDirectoryObjectsRead,
+		UpdateContext: resourceDirectoryObjectsUpdate,
+		DeleteContext: resourceDirectoryObjectsDelete,
+
+		CustomizeDiff: resourceDirectoryObjectsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directoryObjectsDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"content_type_overrides": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateContentTypeOverrides,
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"default_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "application/octet-stream",
+			},
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validGlob,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validGlob,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectoryObjectsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	d.SetId(DirectoryObjectsCreateResourceID(bucket, keyPrefix))
+
+	if err := directoryObjectsSync(ctx, d, meta, true); err != nil {
+		return diag.FromErr(fmt.Errorf("creating S3 Directory Objects (%s): %w", d.Id(), err))
+	}
+
+	return resourceDirectoryObjectsRead(ctx, d, meta)
+}
+
+func resourceDirectoryObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	remote, err := findObjectETagsByPrefix(ctx, conn, bucket, d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Objects (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("reading S3 Directory Objects (%s): %w", d.Id(), err))
+	}
+
+	files := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{}))
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+
+	deleteRemoved := d.Get("delete_removed").(bool)
+
+	for key, hash := range files {
+		etag, ok := remote[key]
+
+		// Objects without a content hash aren't managed.
+		if hash == "" {
+			if !ok || !deleteRemoved {
+				delete(files, key)
+			}
+
+			continue
+		}
+
+		if !ok {
+			log.Printf("[WARN] S3 Object (%s) not found in bucket (%s), will be uploaded", key, bucket)
+			delete(files, key)
+			delete(contentTypes, key)
+			delete(etags, key)
+
+			continue
+		}
+
+		switch recorded := etags[key]; recorded {
+		case "":
+			etags[key] = etag
+		case etag:
+		default:
+			log.Printf("[WARN] S3 Object (%s) in bucket (%s) modified outside Terraform, will be uploaded", key, bucket)
+			delete(files, key)
+			delete(etags, key)
+		}
+	}
+
+	// Remote objects that aren't managed are recorded without a content hash so that they show as removed in the plan.
+	if deleteRemoved {
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = ""
+			}
+		}
+	}
+
+	d.Set("content_types", contentTypes)
+	d.Set("etags", etags)
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceDirectoryObjectsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	uploadAll := d.HasChanges("acl", "cache_control", "kms_key_id", "server_side_encryption", "storage_class")
+
+	if err := directoryObjectsSync(ctx, d, meta, uploadAll); err != nil {
+		return diag.FromErr(fmt.Errorf("updating S3 Directory Objects (%s): %w", d.Id(), err))
+	}
+
+	return resourceDirectoryObjectsRead(ctx, d, meta)
+}
+
+func resourceDirectoryObjectsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	var keys []string
+
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		// Objects without a content hash aren't managed.
+		if hash.(string) != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Objects (%s): %d objects", d.Id(), len(keys))
+	deleted, err := deleteObjectKeys(ctx, conn, d.Get("bucket").(string), keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("deleting S3 Directory Objects (%s): %d of %d objects deleted: %w", d.Id(), len(deleted), len(keys), err))
+	}
+
+	return nil
+}
+
+func resourceDirectoryObjectsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"content_type_overrides", "default_content_type", "exclude", "include", "key_prefix", "source"} {
+		if !d.NewValueKnown(key) {
+			for _, key := range []string{"content_types", "etags", "files"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	}
+
+	local, err := directoryObjectsLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]interface{}, len(local))
+	contentTypes := make(map[string]interface{}, len(local))
+
+	for key, file := range local {
+		files[key] = file.Hash
+		contentTypes[key] = file.ContentType
+	}
+
+	changed := d.HasChanges("acl", "cache_control", "kms_key_id", "server_side_encryption", "storage_class")
+
+	if !reflect.DeepEqual(d.Get("files"), files) {
+		if err := d.SetNew("files", files); err != nil {
+			return err
+		}
+
+		changed = true
+	}
+
+	if !reflect.DeepEqual(d.Get("content_types"), contentTypes) {
+		if err := d.SetNew("content_types", contentTypes); err != nil {
+			return err
+		}
+
+		changed = true
+	}
+
+	if changed {
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+// directoryObjectsLocalFiles returns the local files selected by a resource's configuration.
+func directoryObjectsLocalFiles(d interface{ Get(string) interface{} }) (map[string]localFile, error) {
+	source := d.Get("source").(string)
+	dir, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	if fi, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("reading source (%s): %w", dir, err)
+	} else if !fi.IsDir() {
+		return nil, fmt.Errorf("source (%s) is not a directory", dir)
+	}
+
+	return localDirectory(
+		dir,
+		d.Get("key_prefix").(string),
+		flex.ExpandStringValueSet(d.Get("include").(*schema.Set)),
+		flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)),
+		flex.ExpandStringValueMap(d.Get("content_type_overrides").(map[string]interface{})),
+		d.Get("default_content_type").(string),
+	)
+}
+
+// directoryObjectsSync uploads the local files which are new or have changed since the last apply, or all files if uploadAll is set,
+// and, if delete_removed is set, deletes the objects under the key prefix which no longer exist locally.
+// The objects uploaded and deleted are recorded in state even if an error is returned.
+func directoryObjectsSync(ctx context.Context, d *schema.ResourceData, meta interface{}, uploadAll bool) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	local, err := directoryObjectsLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	oldFiles := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	o, _ = d.GetChange("content_types")
+	oldContentTypes := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	o, _ = d.GetChange("etags")
+	oldETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	files := make(map[string]string)
+	contentTypes := make(map[string]string)
+	etags := make(map[string]string)
+	var uploads []string
+
+	for key, file := range local {
+		if uploadAll || oldFiles[key] != file.Hash || oldContentTypes[key] != file.ContentType || oldETags[key] == "" {
+			uploads = append(uploads, key)
+
+			continue
+		}
+
+		files[key] = file.Hash
+		contentTypes[key] = file.ContentType
+		etags[key] = oldETags[key]
+	}
+
+	sort.Strings(uploads)
+
+	var result *multierror.Error
+	var mu sync.Mutex
+
+	log.Printf("[DEBUG] Uploading S3 Directory Objects (%s): %d of %d objects", d.Id(), len(uploads), len(local))
+	err = uploadObjects(ctx, conn, d, uploads, local, func(key, etag string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		files[key] = local[key].Hash
+		contentTypes[key] = local[key].ContentType
+		etags[key] = etag
+	})
+
+	result = multierror.Append(result, err)
+
+	if d.Get("delete_removed").(bool) {
+		remote, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			result = multierror.Append(result, err)
+		} else {
+			var deletes []string
+
+			for key := range remote {
+				if _, ok := local[key]; !ok {
+					deletes = append(deletes, key)
+				}
+			}
+
+			sort.Strings(deletes)
+
+			log.Printf("[DEBUG] Deleting S3 Directory Objects (%s): %d removed objects", d.Id(), len(deletes))
+			deleted, err := deleteObjectKeys(ctx, conn, bucket, deletes)
+
+			result = multierror.Append(result, err)
+
+			deletedKeys := make(map[string]bool, len(deleted))
+
+			for _, key := range deleted {
+				deletedKeys[key] = true
+			}
+
+			for _, key := range deletes {
+				if !deletedKeys[key] {
+					files[key] = ""
+				}
+			}
+		}
+	}
+
+	d.Set("content_types", contentTypes)
+	d.Set("etags", etags)
+	d.Set("files", files)
+
+	return result.ErrorOrNil()
+}
+
+// uploadObjects uploads local files in parallel, calling uploaded with each object's key and ETag.
+func uploadObjects(ctx context.Context, conn *s3.S3, d *schema.ResourceData, keys []string, local map[string]localFile, uploaded func(key, etag string)) error {
+	uploader := s3manager.NewUploaderWithClient(conn)
+	bucket := d.Get("bucket").(string)
+
+	input := s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	upload := func(key string) error {
+		file, err := os.Open(local[key].Path)
+
+		if err != nil {
+			return fmt.Errorf("opening S3 object source (%s): %w", local[key].Path, err)
+		}
+
+		defer file.Close()
+
+		input := input
+		input.Body = file
+		input.ContentType = aws.String(local[key].ContentType)
+		input.Key = aws.String(key)
+
+		output, err := uploader.UploadWithContext(ctx, &input)
+
+		if err != nil {
+			return fmt.Errorf("uploading S3 object (%s) to bucket (%s): %w", key, bucket, err)
+		}
+
+		uploaded(key, aws.StringValue(output.ETag))
+
+		return nil
+	}
+
+	queue := make(chan string)
+	failures := make(chan error, len(keys))
+	var wg sync.WaitGroup
+
+	for i := 0; i < d.Get("concurrency").(int); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for key := range queue {
+				if err := upload(key); err != nil {
+					failures <- err
+				}
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		queue <- key
+	}
+
+	close(queue)
+	wg.Wait()
+	close(failures)
+
+	var result *multierror.Error
+
+	for err := range failures {
+		result = multierror.Append(result, err)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// findObjectETagsByPrefix returns the ETags of the objects under a key prefix, keyed by object key.
+// The prefix is treated as a directory, see directoryKeyPrefix.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (map[string]string, error) {
+	keyPrefix = directoryKeyPrefix(keyPrefix)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			// Zero-length "directory" placeholder objects, e.g. created by the S3 console, don't correspond to files.
+			if key := aws.StringValue(object.Key); !strings.HasSuffix(key, "/") {
+				etags[key] = aws.StringValue(object.ETag)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+// deleteObjectKeys deletes objects in batches of up to 1,000 keys, returning the keys deleted.
+// In versioned buckets a delete marker is created for each object.
+func deleteObjectKeys(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+	var result *multierror.Error
+
+	for start := 0; start < len(keys); start += deleteObjectsMaxKeys {
+		end := start + deleteObjectsMaxKeys
+
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		var objects []*s3.ObjectIdentifier
+
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return deleted, multierror.Append(result, err).ErrorOrNil()
+		}
+
+		failed := make(map[string]bool)
+
+		for _, v := range output.Errors {
+			key := aws.StringValue(v.Key)
+			failed[key] = true
+			result = multierror.Append(result, fmt.Errorf("deleting S3 object (%s): %s: %s", key, aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		for _, key := range keys[start:end] {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	return deleted, result.ErrorOrNil()
+}
+
+// DirectoryObjectsCreateResourceID returns the ID of an aws_s3_directory_objects resource, BUCKET or BUCKET,KEY_PREFIX.
+func DirectoryObjectsCreateResourceID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return strings.Join([]string{bucket, keyPrefix}, resourceIDSeparator)
+}
+
+func validateContentTypeOverrides(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for ext := range v.(map[string]interface{}) {
+		if !strings.HasPrefix(ext, ".") || strings.ToLower(ext) != ext {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path, "Invalid content_type_overrides key",
+				fmt.Sprintf("%q is not a lowercase file extension beginning with \".\", e.g. \".md\"", ext)))
+		}
+	}
+
+	return diags
+}
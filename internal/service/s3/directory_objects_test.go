@@ -0,0 +1,401 @@
+package s3_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectoryObjects_basic(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryObjectsCreateTempDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"img/.DS_Store":   "",
+		"drafts/draft.md": "# Draft",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectsKeys(rName, "site/css/site.css", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "id", rName+",site/"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.site/index.html", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.site/css/site.css", "text/css; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsWriteFile(t, source, "index.html", "<html><body></body></html>")
+					testAccDirectoryObjectsWriteFile(t, source, "js/app.js", "console.log(1)")
+
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					// css/site.css isn't deleted without delete_removed.
+					testAccCheckDirectoryObjectsKeys(rName, "site/css/site.css", "site/index.html", "site/js/app.js"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.site/js/app.js", "text/javascript; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_deleteRemoved(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryObjectsCreateTempDir(t, map[string]string{
+		"a.txt": "a",
+		"b.txt": "b",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_deleteRemoved(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectsKeys(rName, "a.txt", "b.txt", "unmanaged.txt"),
+				),
+				// The unmanaged object is created after the directory objects and is deleted on the next apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "b.txt")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_deleteRemovedNoUnmanaged(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectsKeys(rName, "a.txt"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_contentTypeOverrides(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryObjectsCreateTempDir(t, map[string]string{
+		"README.md": "# Read me",
+		"LICENSE":   "MPL-2.0",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_contentTypeOverrides(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "content_types.README.md", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "content_types.LICENSE", "text/plain; charset=utf-8"),
+					testAccCheckDirectoryObjectsContentType(rName, "README.md", "text/plain"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccS3DirectoryObjects_kms verifies that objects encrypted with a KMS key, whose ETags aren't MD5 digests,
+// and objects uploaded in multiple parts don't cause a diff after apply.
+func TestAccS3DirectoryObjects_kms(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryObjectsCreateTempDir(t, map[string]string{
+		"small.txt": "small",
+		"large.bin": string(make([]byte, 6*1024*1024)), // Larger than s3manager's default part size.
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_kms(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectsKeys(rName, "large.bin", "small.txt"),
+					resource.TestMatchResourceAttr(resourceName, "etags.large.bin", regexp.MustCompile(`-\d+"$`)),
+				),
+			},
+			{
+				Config:   testAccDirectoryObjectsConfig_kms(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_modifiedOutsideTerraform(t *testing.T) {
+	resourceName := "aws_s3_directory_objects.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectoryObjectsCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryObjectsPutObject(rName, "site/index.html", "modified"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectoryObjectsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_directory_objects" {
+			continue
+		}
+
+		for k, hash := range rs.Primary.Attributes {
+			key := strings.TrimPrefix(k, "files.")
+
+			if key == k || key == "%" || hash == "" {
+				continue
+			}
+
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(key),
+			})
+
+			if err == nil {
+				return fmt.Errorf("S3 Object %s still exists in bucket %s", key, rs.Primary.Attributes["bucket"])
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDirectoryObjectsKeys(bucket string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		var keys []string
+
+		err := conn.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(bucket)}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, object := range page.Contents {
+				keys = append(keys, aws.StringValue(object.Key))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := fmt.Sprint(keys), fmt.Sprint(expected); got != want {
+			return fmt.Errorf("got S3 objects %s, expected %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectsContentType(bucket, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.ContentType); got != expected {
+			return fmt.Errorf("got S3 Object (%s) Content-Type %s, expected %s", key, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectsPutObject(bucket, key, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err := conn.PutObject(&s3.PutObjectInput{
+			Body:   strings.NewReader(content),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccDirectoryObjectsCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for filename, content := range files {
+		testAccDirectoryObjectsWriteFile(t, dir, filename, content)
+	}
+
+	return dir
+}
+
+func testAccDirectoryObjectsWriteFile(t *testing.T, dir, filename, content string) {
+	filename = filepath.Join(dir, filepath.FromSlash(filename))
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectoryObjectsConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  key_prefix = "site/"
+  exclude    = ["**/.DS_Store", "drafts/**"]
+}
+`, rName, source)
+}
+
+func testAccDirectoryObjectsConfig_deleteRemoved(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source         = %[2]q
+  delete_removed = true
+}
+
+resource "aws_s3_object" "unmanaged" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "unmanaged.txt"
+  content = "unmanaged"
+
+  depends_on = [aws_s3_directory_objects.test]
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectoryObjectsConfig_deleteRemovedNoUnmanaged(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source         = %[2]q
+  delete_removed = true
+}
+`, rName, source)
+}
+
+func testAccDirectoryObjectsConfig_contentTypeOverrides(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket               = aws_s3_bucket.test.bucket
+  source               = %[2]q
+  default_content_type = "text/plain; charset=utf-8"
+
+  content_type_overrides = {
+    ".md" = "text/plain"
+  }
+}
+`, rName, source)
+}
+
+func testAccDirectoryObjectsConfig_kms(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  kms_key_id = aws_kms_key.test.arn
+}
+`, rName, source)
+}
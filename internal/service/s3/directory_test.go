@@ -0,0 +1,198 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGlobRegexp(t *testing.T) {
+	testCases := []struct {
+		glob     string
+		matches  []string
+		excludes []string
+	}{
+		{
+			glob:     "*.html",
+			matches:  []string{"index.html"},
+			excludes: []string{"docs/index.html", "index.htm"},
+		},
+		{
+			glob:     "**/*.html",
+			matches:  []string{"index.html", "docs/index.html", "docs/guide/index.html"},
+			excludes: []string{"index.css"},
+		},
+		{
+			glob:     "drafts/**",
+			matches:  []string{"drafts/a.md", "drafts/b/c.md"},
+			excludes: []string{"drafts", "other/drafts/a.md"},
+		},
+		{
+			glob:     "img/?.png",
+			matches:  []string{"img/a.png"},
+			excludes: []string{"img/ab.png", "img//.png"},
+		},
+		{
+			glob:     "*.{css,js}",
+			matches:  []string{"site.css", "site.js"},
+			excludes: []string{"site.json", "a,b"},
+		},
+		{
+			glob:     "[!.]*",
+			matches:  []string{"index.html"},
+			excludes: []string{".DS_Store"},
+		},
+		{
+			glob:     `a\*b`,
+			matches:  []string{"a*b"},
+			excludes: []string{"axb"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		re, err := globRegexp(testCase.glob)
+
+		if err != nil {
+			t.Errorf("globRegexp(%q): %s", testCase.glob, err)
+
+			continue
+		}
+
+		for _, s := range testCase.matches {
+			if !re.MatchString(s) {
+				t.Errorf("glob %q (%s) does not match %q", testCase.glob, re, s)
+			}
+		}
+
+		for _, s := range testCase.excludes {
+			if re.MatchString(s) {
+				t.Errorf("glob %q (%s) matches %q", testCase.glob, re, s)
+			}
+		}
+	}
+}
+
+func TestGlobRegexp_invalid(t *testing.T) {
+	for _, glob := range []string{"{a,b", "a}", "[abc"} {
+		if _, err := globRegexp(glob); err == nil {
+			t.Errorf("globRegexp(%q): expected error", glob)
+		}
+	}
+}
+
+func TestContentTypeByExtension(t *testing.T) {
+	overrides := map[string]string{
+		".md":  "text/plain",
+		".foo": "application/x-foo",
+	}
+
+	testCases := map[string]string{
+		"index.html":    "text/html; charset=utf-8",
+		"INDEX.HTML":    "text/html; charset=utf-8",
+		"js/app.min.js": "text/javascript; charset=utf-8",
+		"README.md":     "text/plain",
+		"data.foo":      "application/x-foo",
+		"data.unknown":  "binary/octet-stream",
+		"LICENSE":       "binary/octet-stream",
+	}
+
+	for filename, expected := range testCases {
+		if got := contentTypeByExtension(filename, overrides, "binary/octet-stream"); got != expected {
+			t.Errorf("contentTypeByExtension(%q) = %q, expected %q", filename, got, expected)
+		}
+	}
+}
+
+func TestLocalDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	for filename, content := range map[string]string{
+		"index.html":       "<html></html>",
+		"css/site.css":     "body {}",
+		"drafts/post.md":   "# Draft",
+		"img/.DS_Store":    "",
+		"img/logo.svg":     "<svg/>",
+		"nested/a/b/c.txt": "c",
+	} {
+		filename = filepath.Join(dir, filepath.FromSlash(filename))
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := localDirectory(dir, "site/", []string{"**/*.{html,css,svg,txt}", "**/.DS_Store"}, []string{"**/.DS_Store", "drafts/**"}, nil, "application/octet-stream")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]string)
+
+	for key, file := range files {
+		got[key] = file.ContentType
+	}
+
+	expected := map[string]string{
+		"site/css/site.css":     "text/css; charset=utf-8",
+		"site/img/logo.svg":     "image/svg+xml",
+		"site/index.html":       "text/html; charset=utf-8",
+		"site/nested/a/b/c.txt": "text/plain; charset=utf-8",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got files %v, expected %v", got, expected)
+	}
+
+	// SHA-256 of "c".
+	if got, expected := files["site/nested/a/b/c.txt"].Hash, "2e7d2c03a9507ae265ecf5b5356885a53393a2029d241394997265a1a25aefc6"; got != expected {
+		t.Errorf("got hash %s, expected %s", got, expected)
+	}
+}
+
+func TestDirectoryKeyPrefix(t *testing.T) {
+	testCases := map[string]string{
+		"":           "",
+		"site":       "site/",
+		"site/":      "site/",
+		"site/docs":  "site/docs/",
+		"site/docs/": "site/docs/",
+	}
+
+	for keyPrefix, expected := range testCases {
+		if got := directoryKeyPrefix(keyPrefix); got != expected {
+			t.Errorf("directoryKeyPrefix(%q) = %q, expected %q", keyPrefix, got, expected)
+		}
+	}
+
+	// Objects under sibling prefixes must not be treated as under the key prefix, e.g. deleted by delete_removed.
+	for _, key := range []string{"sitemap.xml", "site-backup/index.html", "site.html"} {
+		if strings.HasPrefix(key, directoryKeyPrefix("site")) {
+			t.Errorf("key %q is under key prefix %q", key, "site")
+		}
+	}
+}
+
+func TestLocalDirectory_keyPrefixWithoutSlash(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := localDirectory(dir, "site", nil, nil, nil, "application/octet-stream")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := files["site/index.html"]; !ok || len(files) != 1 {
+		t.Errorf("got files %v, expected only site/index.html", files)
+	}
+}
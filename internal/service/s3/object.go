@@ -70,6 +70,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -203,6 +224,12 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 		Key:    aws.String(key),
 	}
 
+	checksumAlgorithm := d.Get("checksum_algorithm").(string)
+
+	if checksumAlgorithm != "" {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.Retry(objectCreationTimeout, func() *resource.RetryError {
@@ -266,7 +293,23 @@ func resourceObjectRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+
+	// The ETag of a multipart upload or a KMS-encrypted object is not the MD5 digest of its content, so it can't be compared with filemd5().
+	// If the object has an additional checksum which is unchanged since it was uploaded, keep the configured ETag, if any.
+	// Changes made outside Terraform are detected by comparing the checksum with the configured content in CustomizeDiff.
+	remoteChecksum := checksumField(checksumAlgorithm, resp.ChecksumCRC32, resp.ChecksumCRC32C, resp.ChecksumSHA1, resp.ChecksumSHA256)
+	storedChecksum := ""
+
+	if checksumAlgorithm != "" {
+		storedChecksum = d.Get(checksumAttributes[checksumAlgorithm]).(string)
+	}
+
+	if remoteChecksum == "" || storedChecksum == "" || !checksumsEqual(remoteChecksum, storedChecksum) || d.Get("etag").(string) == "" {
+		d.Set("etag", etag)
+	}
+
+	setChecksums(d, resp.ChecksumCRC32, resp.ChecksumCRC32C, resp.ChecksumSHA1, resp.ChecksumSHA256)
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -532,7 +575,15 @@ func resourceObjectUpload(d *schema.ResourceData, meta interface{}) error {
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		_, checksum, err := uploadWithChecksum(uploader, input, v.(string))
+
+		if err != nil {
+			return fmt.Errorf("uploading object to S3 bucket (%s): %s", bucket, err)
+		}
+
+		d.Set(checksumAttributes[v.(string)], checksum)
+	} else if _, err := uploader.Upload(input); err != nil {
 		return fmt.Errorf("uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -574,6 +625,12 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		for _, attribute := range checksumAttributes {
+			if err := d.SetNewComputed(attribute); err != nil {
+				return err
+			}
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -582,6 +639,46 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 		d.SetNewComputed("etag")
 	}
 
+	// If the object has an additional checksum, compare it with the checksum of the configured content
+	// to detect changes made outside Terraform, e.g. to KMS-encrypted objects whose ETag can't be compared with filemd5().
+	if d.Id() == "" {
+		return nil
+	}
+
+	checksumAlgorithm := d.Get("checksum_algorithm").(string)
+
+	if checksumAlgorithm == "" {
+		return nil
+	}
+
+	remoteChecksum := d.Get(checksumAttributes[checksumAlgorithm]).(string)
+
+	if remoteChecksum == "" {
+		return nil
+	}
+
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	checksum, err := configuredObjectChecksum(d, checksumAlgorithm)
+
+	if err != nil {
+		return err
+	}
+
+	if !checksumsEqual(checksum, remoteChecksum) {
+		log.Printf("[WARN] S3 Object (%s) %s checksum changed from %s to %s outside Terraform", d.Id(), checksumAlgorithm, checksum, remoteChecksum)
+
+		for _, key := range []string{"etag", "version_id", checksumAttributes[checksumAlgorithm]} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -589,6 +686,7 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
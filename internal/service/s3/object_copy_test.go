@@ -83,6 +83,43 @@ func TestAccS3ObjectCopy_BucketKeyEnabled_object(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectCopy_checksumAlgorithm(t *testing.T) {
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	sourceKey := "WshngtnNtnls"
+	key := "HundBegraven"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, "CRC32C"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", "JUrHHg=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				Config: testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, "SHA1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", "jJAmclKRAT/qjnalJrQbfan0ODI="),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectCopyDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -234,3 +271,29 @@ resource "aws_s3_object_copy" "test" {
 }
 `, rName)
 }
+
+func testAccObjectCopyConfig_checksumAlgorithm(rName1, sourceKey, rName2, key, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = %[2]q
+  content = "Ingen ko på isen"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = %[3]q
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket = aws_s3_bucket.target.bucket
+  key    = %[4]q
+  source = "${aws_s3_bucket.source.bucket}/${aws_s3_object.source.key}"
+
+  checksum_algorithm = %[5]q
+}
+`, rName1, sourceKey, rName2, key, checksumAlgorithm)
+}
@@ -24,12 +24,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -159,7 +159,7 @@ func sweepBuckets(region string) error {
 		d := r.Data(nil)
 		d.SetId(name)
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithName(name), sweep.WithCreatedAt(bucket.CreationDate)))
 	}
 
 	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
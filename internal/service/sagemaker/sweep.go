@@ -17,32 +17,32 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
 		Name: "aws_sagemaker_app_image_config",
 		F:    sweepAppImagesConfig,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
 		Name: "aws_sagemaker_app",
 		F:    sweepApps,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    sweepCodeRepositories,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_device_fleet", &resource.Sweeper{
 		Name: "aws_sagemaker_device_fleet",
 		F:    sweepDeviceFleets,
 	})
 
-	// resource.AddTestSweepers("aws_sagemaker_device", &resource.Sweeper{
+	// sweep.AddTestSweepers("aws_sagemaker_device", &resource.Sweeper{
 	// 	Name: "aws_sagemaker_device",
 	// 	F:    sweepDevices,
 	// })
 
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    sweepDomains,
 		Dependencies: []string{
@@ -52,7 +52,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint_configuration",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -60,7 +60,7 @@ func init() {
 		F: sweepEndpointConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
 		Name: "aws_sagemaker_endpoint",
 		Dependencies: []string{
 			"aws_sagemaker_model",
@@ -69,37 +69,37 @@ func init() {
 		F: sweepEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    sweepFeatureGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_flow_definition", &resource.Sweeper{
 		Name: "aws_sagemaker_flow_definition",
 		F:    sweepFlowDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_human_task_ui", &resource.Sweeper{
 		Name: "aws_sagemaker_human_task_ui",
 		F:    sweepHumanTaskUIs,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
 		Name: "aws_sagemaker_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
 		Name: "aws_sagemaker_model",
 		F:    sweepModels,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -107,12 +107,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    sweepNotebookInstances,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
 		Dependencies: []string{
@@ -120,7 +120,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    sweepUserProfiles,
 		Dependencies: []string{
@@ -128,7 +128,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workforce", &resource.Sweeper{
 		Name: "aws_sagemaker_workforce",
 		F:    sweepWorkforces,
 		Dependencies: []string{
@@ -136,12 +136,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_workteam", &resource.Sweeper{
 		Name: "aws_sagemaker_workteam",
 		F:    sweepWorkteams,
 	})
 
-	resource.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sagemaker_project", &resource.Sweeper{
 		Name: "aws_sagemaker_project",
 		F:    sweepProjects,
 	})
@@ -177,7 +177,7 @@ func sweepAppImagesConfig(region string) error {
 			r := ResourceAppImageConfig()
 			d := r.Data(nil)
 			d.SetId(name)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("deleting SageMaker App Image Config (%s): %w", name, err))
 				continue
@@ -217,7 +217,7 @@ func sweepApps(region string) error {
 			d.Set("domain_id", app.DomainId)
 			d.Set("user_profile_name", app.UserProfileName)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -253,7 +253,7 @@ func sweepCodeRepositories(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(instance.CodeRepositoryName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -291,7 +291,7 @@ func sweepDeviceFleets(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -329,7 +329,7 @@ func sweepDeviceFleets(region string) error {
 // 			d := r.Data(nil)
 // 			d.SetId(name)
 
-// 			err := r.Delete(d, client)
+// 			err := sweep.DeleteResource(r, d, client)
 // 			if err != nil {
 // 				sweeperErrs = multierror.Append(sweeperErrs, err)
 // 				continue
@@ -367,7 +367,7 @@ func sweepDomains(region string) error {
 			d.SetId(aws.StringValue(domain.DomainId))
 			d.Set("retention_policy.0.home_efs_file_system", "Delete")
 
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -406,7 +406,7 @@ func sweepEndpointConfigurations(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(endpointConfig.EndpointConfigName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -429,6 +429,10 @@ func sweepEndpointConfigurations(region string) error {
 }
 
 func sweepEndpoints(region string) error {
+	if err := sweep.CheckDirectDeletion(); err != nil {
+		return err
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -474,7 +478,7 @@ func sweepFeatureGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(group.FeatureGroupName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -511,7 +515,7 @@ func sweepFlowDefinitions(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(flowDefinition.FlowDefinitionName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -548,7 +552,7 @@ func sweepHumanTaskUIs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(humanTaskUi.HumanTaskUiName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -584,7 +588,7 @@ func sweepImages(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(image.ImageName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -620,7 +624,7 @@ func sweepModelPackageGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(modelPackageGroup.ModelPackageGroupName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -657,7 +661,7 @@ func sweepModels(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(model.ModelName))
 
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -699,7 +703,7 @@ func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(lifecycleConfig.NotebookInstanceLifecycleConfigName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -734,7 +738,7 @@ func sweepNotebookInstances(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -771,7 +775,7 @@ func sweepStudioLifecyclesConfig(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(config.StudioLifecycleConfigName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -810,7 +814,7 @@ func sweepUserProfiles(region string) error {
 			d.Set("user_profile_name", userProfile.UserProfileName)
 			d.Set("domain_id", userProfile.DomainId)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -847,7 +851,7 @@ func sweepWorkforces(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(workforce.WorkforceName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -884,7 +888,7 @@ func sweepWorkteams(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(workteam.WorkteamName))
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -922,7 +926,7 @@ func sweepProjects(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, err)
 				continue
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
@@ -21,6 +22,10 @@ func DataSourceSecretVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"encrypted_secret_binary": encryption.EncryptedValueSchema(),
+			"encrypted_secret_string": encryption.EncryptedValueSchema(),
+			"key_fingerprint":         encryption.KeyFingerprintSchema(),
+			"pgp_key":                 encryption.DataSourcePGPKeySchema(),
 			"secret_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -35,6 +40,7 @@ func DataSourceSecretVersion() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"use_provider_pgp_key": encryption.DataSourceUseProviderPGPKeySchema(),
 			"version_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -85,13 +91,24 @@ func dataSourceSecretVersionRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error reading Secrets Manager Secret Version: %w", err)
 	}
 
+	encryptionKey, err := encryption.ResolvePGPKey(d, meta.(*conns.AWSClient).PGPKey)
+	if err != nil {
+		return err
+	}
+
 	d.SetId(fmt.Sprintf("%s|%s", secretID, version))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
 	d.Set("version_id", output.VersionId)
-	d.Set("secret_binary", string(output.SecretBinary))
 	d.Set("arn", output.ARN)
 
+	err = encryption.SetSecrets(d, encryptionKey, map[string]string{
+		"secret_binary": string(output.SecretBinary),
+		"secret_string": aws.StringValue(output.SecretString),
+	})
+	if err != nil {
+		return fmt.Errorf("error setting Secrets Manager Secret %q Version %q secrets: %w", secretID, version, err)
+	}
+
 	if err := d.Set("version_stages", flex.FlattenStringList(output.VersionStages)); err != nil {
 		return fmt.Errorf("error setting version_stages: %w", err)
 	}
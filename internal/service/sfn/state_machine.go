@@ -1,6 +1,7 @@
 package sfn
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,10 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -19,10 +22,10 @@ import (
 
 func ResourceStateMachine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceStateMachineCreate,
-		Read:   resourceStateMachineRead,
-		Update: resourceStateMachineUpdate,
-		Delete: resourceStateMachineDelete,
+		Create:               resourceStateMachineCreate,
+		Read:                 resourceStateMachineRead,
+		UpdateWithoutTimeout: resourceStateMachineUpdate,
+		Delete:               resourceStateMachineDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -39,9 +42,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringLenBetween(0, 1024*1024), // 1048576
+				DiffSuppressFunc: verify.StepFunctionsDefinitionNormalizer.SuppressDiffs,
 			},
 
 			"logging_configuration": {
@@ -250,7 +254,8 @@ func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := verify.StepFunctionsDefinitionNormalizer.ChangeWarnings(d, "definition")
 	conn := meta.(*conns.AWSClient).SFNConn
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -277,7 +282,7 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 		_, err := conn.UpdateStateMachine(input)
 
 		if err != nil {
-			return fmt.Errorf("error updating Step Function State Machine (%s): %w", d.Id(), err)
+			return errs.AppendErrorf(diags, "updating Step Function State Machine (%s): %s", d.Id(), err)
 		}
 
 		// Handle eventual consistency after update.
@@ -300,18 +305,18 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 		})
 
 		if tfresource.TimedOut(err) {
-			return fmt.Errorf("timed out waiting for Step Function State Machine (%s) update", d.Id())
+			return errs.AppendErrorf(diags, "timed out waiting for Step Function State Machine (%s) update", d.Id())
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return errs.AppendErrorf(diags, "updating Step Function State Machine (%s) tags: %s", d.Id(), err)
 		}
 	}
 
-	return resourceStateMachineRead(d, meta)
+	return append(diags, diag.FromErr(resourceStateMachineRead(d, meta))...)
 }
 
 func resourceStateMachineDelete(d *schema.ResourceData, meta interface{}) error {
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -54,7 +54,7 @@ func init() {
 func sweepLanguageModels(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
 	ctx := context.Background()
@@ -69,7 +69,7 @@ func sweepLanguageModels(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
 			return nil
 		}
 
@@ -104,7 +104,7 @@ func sweepLanguageModels(region string) error {
 func sweepMedicalVocabularies(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
 	ctx := context.Background()
@@ -113,17 +113,21 @@ func sweepMedicalVocabularies(region string) error {
 	in := &transcribe.ListMedicalVocabulariesInput{}
 	var errs *multierror.Error
 
-	for {
-		out, err := conn.ListMedicalVocabularies(ctx, in)
+	pages := transcribe.NewListMedicalVocabulariesPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
+
 		if err != nil {
 			return fmt.Errorf("error retrieving Transcribe Medical Vocabularies: %w", err)
 		}
 
-		for _, vocab := range out.Vocabularies {
+		for _, vocab := range page.Vocabularies {
 			name := aws.ToString(vocab.VocabularyName)
 			log.Printf("[INFO] Deleting Transcribe Medical Vocabularies: %s", name)
 
@@ -133,11 +137,6 @@ func sweepMedicalVocabularies(region string) error {
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-
-		if aws.ToString(out.NextToken) == "" {
-			break
-		}
-		in.NextToken = out.NextToken
 	}
 
 	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -155,7 +154,7 @@ func sweepMedicalVocabularies(region string) error {
 func sweepVocabularies(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
 	ctx := context.Background()
@@ -164,17 +163,21 @@ func sweepVocabularies(region string) error {
 	in := &transcribe.ListVocabulariesInput{}
 	var errs *multierror.Error
 
-	for {
-		out, err := conn.ListVocabularies(ctx, in)
+	pages := transcribe.NewListVocabulariesPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
+
 		if err != nil {
 			return fmt.Errorf("error retrieving Transcribe Vocabularies: %w", err)
 		}
 
-		for _, vocab := range out.Vocabularies {
+		for _, vocab := range page.Vocabularies {
 			name := aws.ToString(vocab.VocabularyName)
 			log.Printf("[INFO] Deleting Transcribe Vocabularies: %s", name)
 
@@ -184,11 +187,6 @@ func sweepVocabularies(region string) error {
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-
-		if aws.ToString(out.NextToken) == "" {
-			break
-		}
-		in.NextToken = out.NextToken
 	}
 
 	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -206,7 +204,7 @@ func sweepVocabularies(region string) error {
 func sweepVocabularyFilters(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 
 	ctx := context.Background()
@@ -215,18 +213,21 @@ func sweepVocabularyFilters(region string) error {
 	in := &transcribe.ListVocabularyFiltersInput{}
 	var errs *multierror.Error
 
-	for {
-		out, err := conn.ListVocabularyFilters(ctx, in)
+	pages := transcribe.NewListVocabularyFiltersPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
 		if sweep.SkipSweepError(err) {
-			log.Println("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
+			log.Printf("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
 			return nil
 		}
+
 		if err != nil {
 			return fmt.Errorf("error retrieving Transcribe Vocabulary Filters: %w", err)
 		}
 
-		log.Println(out)
-		for _, filter := range out.VocabularyFilters {
+		for _, filter := range page.VocabularyFilters {
 			name := aws.ToString(filter.VocabularyFilterName)
 			log.Printf("[INFO] Deleting Transcribe Vocabulary Filter: %s", name)
 
@@ -236,11 +237,6 @@ func sweepVocabularyFilters(region string) error {
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-
-		if aws.ToString(out.NextToken) == "" {
-			break
-		}
-		in.NextToken = out.NextToken
 	}
 
 	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
@@ -0,0 +1,100 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter decides whether a sweep candidate must be kept.
+// If the resource is to be kept, Filter returns true and a human-readable reason.
+// Filters fail closed: a resource is kept if the metadata a filter needs is unavailable.
+type Filter func(ResourceMetadata) (bool, string)
+
+// metadataUnavailable returns the reason for keeping a resource whose specified metadata is unavailable.
+func metadataUnavailable(field string) string {
+	return fmt.Sprintf("metadata unavailable: %s is unknown", field)
+}
+
+// now is overridden in unit tests.
+var now = time.Now
+
+// KeepYoungerThan returns a Filter that keeps resources created less than minAge ago.
+// Resources with unknown creation time are kept.
+func KeepYoungerThan(minAge time.Duration) Filter {
+	return func(md ResourceMetadata) (bool, string) {
+		if md.CreatedAt == nil {
+			return true, metadataUnavailable("creation time")
+		}
+
+		if age := now().Sub(*md.CreatedAt); age < minAge {
+			return true, fmt.Sprintf("age %s is less than %s", age.Round(time.Second), minAge)
+		}
+
+		return false, ""
+	}
+}
+
+// KeepNamePrefixes returns a Filter that keeps resources whose name starts with any of the specified prefixes.
+// If the name is unknown, resources whose ID starts with any of the prefixes are kept
+// and, as the ID may not be the name, so are all other resources.
+func KeepNamePrefixes(prefixes ...string) Filter {
+	return func(md ResourceMetadata) (bool, string) {
+		name := md.Name
+		if name == "" {
+			name = md.ID
+		}
+
+		for _, prefix := range prefixes {
+			if prefix != "" && strings.HasPrefix(name, prefix) {
+				return true, fmt.Sprintf("name %q has protected prefix %q", name, prefix)
+			}
+		}
+
+		if md.Name == "" {
+			return true, metadataUnavailable("name")
+		}
+
+		return false, ""
+	}
+}
+
+// KeepTagged returns a Filter that keeps resources tagged with the specified key.
+// If value is not empty the tag's value must also match.
+// Resources with unknown tags are kept.
+func KeepTagged(key, value string) Filter {
+	return func(md ResourceMetadata) (bool, string) {
+		if md.Tags == nil {
+			return true, metadataUnavailable("tags")
+		}
+
+		v, ok := md.Tags[key]
+
+		if !ok {
+			return false, ""
+		}
+
+		if value == "" {
+			return true, fmt.Sprintf("tagged with %q", key)
+		}
+
+		if v == value {
+			return true, fmt.Sprintf("tagged with %q=%q", key, value)
+		}
+
+		return false, ""
+	}
+}
+
+func keep(md ResourceMetadata, filters []Filter) (bool, string) {
+	for _, filter := range filters {
+		if ok, reason := filter(md); ok {
+			return true, reason
+		}
+	}
+
+	return false, ""
+}
@@ -22,19 +22,40 @@ import (
 // Terraform Plugin Framework variants of sweeper helpers.
 
 type SweepFrameworkResource struct {
-	factory func(context.Context) (intf.ResourceWithConfigureAndImportState, error)
-	id      string // TODO Currently we can only delete a resource if "id" is the only attribute used.
-	meta    interface{}
+	factory     func(context.Context) (intf.ResourceWithConfigureAndImportState, error)
+	id          string // TODO Currently we can only delete a resource if "id" is the only attribute used.
+	meta        interface{}
+	metadataFns []MetadataFunc
 }
 
-func NewSweepFrameworkResource(factory func(context.Context) (intf.ResourceWithConfigureAndImportState, error), id string, meta interface{}) *SweepFrameworkResource {
+func NewSweepFrameworkResource(factory func(context.Context) (intf.ResourceWithConfigureAndImportState, error), id string, meta interface{}, metadataFns ...MetadataFunc) *SweepFrameworkResource {
 	return &SweepFrameworkResource{
-		factory: factory,
-		id:      id,
-		meta:    meta,
+		factory:     factory,
+		id:          id,
+		meta:        meta,
+		metadataFns: metadataFns,
 	}
 }
 
+// Metadata returns the resource's type name and ID and any additional metadata supplied by the sweeper.
+func (sr *SweepFrameworkResource) Metadata(ctx context.Context) ResourceMetadata {
+	md := ResourceMetadata{
+		ID: sr.id,
+	}
+
+	if resource, err := sr.factory(ctx); err == nil {
+		response := fwresource.MetadataResponse{}
+		resource.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+		md.Type = response.TypeName
+	}
+
+	for _, fn := range sr.metadataFns {
+		fn(&md)
+	}
+
+	return md
+}
+
 func (sr *SweepFrameworkResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		err := DeleteFrameworkResource(sr.factory, sr.id, sr.meta)
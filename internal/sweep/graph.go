@@ -0,0 +1,302 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Graph orders the deletion of resource types.
+type Graph struct {
+	g           *depgraph.Graph
+	concurrency map[string]int
+}
+
+// sweeperGraph returns a graph of the specified sweepers' resource types, ordered by the sweepers' dependencies.
+// Returns an error if a dependency cycle is detected.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*Graph, error) {
+	g := NewGraph()
+
+	for name, sweeper := range sweepers {
+		if err := g.AddResourceType(name, sweeper.Dependencies...); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := g.Waves(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// NewGraph returns a new, empty resource type graph.
+func NewGraph() *Graph {
+	return &Graph{
+		g:           depgraph.New(),
+		concurrency: make(map[string]int),
+	}
+}
+
+// AddResourceType adds a resource type to the graph.
+// Resources of the types listed in dependencies are deleted before resources of this type,
+// e.g. a VPC's dependencies include its network interfaces and security groups.
+func (g *Graph) AddResourceType(typeName string, dependencies ...string) error {
+	g.g.AddNode(typeName)
+
+	for _, dependency := range dependencies {
+		g.g.AddNode(dependency)
+
+		if err := g.g.AddDependency(typeName, dependency); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetConcurrency limits the number of concurrent deletions in the wave containing the resource type.
+// If a wave contains several resource types with limits, the smallest limit applies.
+func (g *Graph) SetConcurrency(typeName string, n int) {
+	g.concurrency[typeName] = n
+}
+
+// Wave is a set of resource types whose resources can be deleted concurrently.
+type Wave struct {
+	Types       []string
+	Concurrency int // Zero means unlimited
+}
+
+// Waves returns the graph's resource types grouped into waves, in deletion order.
+// Each resource type is placed in the wave after that of its last deleted dependency.
+// Resource types within a wave are sorted by name.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Waves() ([]Wave, error) {
+	order, err := g.g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make(map[string]int)
+	waves := make([]Wave, 0)
+
+	for _, typeName := range order {
+		dependencies, err := g.g.DirectDependenciesOf(typeName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		level := 0
+		for _, dependency := range dependencies {
+			if l := levels[dependency] + 1; l > level {
+				level = l
+			}
+		}
+		levels[typeName] = level
+
+		for len(waves) <= level {
+			waves = append(waves, Wave{})
+		}
+
+		wave := &waves[level]
+		wave.Types = append(wave.Types, typeName)
+
+		if n := g.concurrency[typeName]; n > 0 && (wave.Concurrency == 0 || n < wave.Concurrency) {
+			wave.Concurrency = n
+		}
+	}
+
+	for _, wave := range waves {
+		sort.Strings(wave.Types)
+	}
+
+	return waves, nil
+}
+
+// sweepInWaves deletes sweepables one wave at a time in the order defined by opts.Graph.
+// Sweepables whose type is not in the graph are deleted in the first wave.
+// Failed deletions are retried up to opts.WaveRetries times, after which resources of all types
+// that depend on the failed type are skipped.
+// This only orders the sweepables passed to a single orchestrator call, e.g. by a sweeper that sweeps
+// several resource types. Sweepers themselves are ordered, and skipped, by runSweepers.
+func sweepInWaves(ctx context.Context, sweepables []Sweepable, opts OrchestratorOptions, optFns ...tfresource.OptionsFunc) error {
+	waves, err := opts.Graph.Waves()
+
+	if err != nil {
+		return err
+	}
+
+	if len(waves) == 0 {
+		waves = append(waves, Wave{})
+	}
+
+	type candidate struct {
+		sweepable Sweepable
+		metadata  ResourceMetadata
+	}
+
+	candidates := make(map[string][]candidate)
+	for _, sweepable := range sweepables {
+		md := describe(ctx, sweepable)
+		typeName := md.Type
+
+		if !opts.Graph.g.HasNode(typeName) {
+			typeName = ""
+		}
+
+		candidates[typeName] = append(candidates[typeName], candidate{sweepable: sweepable, metadata: md})
+	}
+
+	var errs *multierror.Error
+	failedTypes := make(map[string]struct{})
+
+	for i, wave := range waves {
+		pending := make([]candidate, 0)
+		typeNames := wave.Types
+
+		if i == 0 {
+			typeNames = append([]string{""}, typeNames...)
+		}
+
+		for _, typeName := range typeNames {
+			if dependency := failedDependency(opts.Graph.g, typeName, failedTypes); dependency != "" {
+				failedTypes[typeName] = struct{}{}
+
+				for _, c := range candidates[typeName] {
+					reason := fmt.Sprintf("dependency %s was not swept", dependency)
+					log.Printf("[WARN] Skipping %s (%s): %s", c.metadata.Type, c.metadata.ID, reason)
+					opts.record(ReportEntry{ResourceMetadata: c.metadata, Outcome: OutcomeSkipped, Reason: reason})
+				}
+
+				continue
+			}
+
+			pending = append(pending, candidates[typeName]...)
+		}
+
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt > 0 {
+				log.Printf("[INFO] Retrying %d failed deletions in sweep wave %d (attempt %d)", len(pending), i, attempt+1)
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(opts.WaveRetryDelay):
+				}
+			}
+
+			entries, waveErrs := sweepConcurrently(ctx, len(pending), wave.Concurrency, func(ctx context.Context, j int) (ReportEntry, error) {
+				return sweepOne(ctx, pending[j].sweepable, pending[j].metadata, opts, optFns...)
+			})
+
+			failed := make([]candidate, 0)
+			for j, err := range waveErrs {
+				entries[j].ResourceMetadata = pending[j].metadata
+
+				// Don't retry once the context is done.
+				if err != nil && ctx.Err() != nil {
+					errs = multierror.Append(errs, err)
+					failedTypes[pending[j].metadata.Type] = struct{}{}
+					opts.record(entries[j])
+					continue
+				}
+
+				if err != nil && attempt < opts.WaveRetries && !SkipSweepError(err) {
+					failed = append(failed, pending[j])
+					continue
+				}
+
+				if err != nil {
+					errs = multierror.Append(errs, err)
+					failedTypes[pending[j].metadata.Type] = struct{}{}
+				}
+
+				opts.record(entries[j])
+			}
+
+			pending = failed
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// failedDependency returns the first direct dependency of the specified resource type that was not swept.
+func failedDependency(g *depgraph.Graph, typeName string, failedTypes map[string]struct{}) string {
+	if !g.HasNode(typeName) {
+		return ""
+	}
+
+	dependencies, _ := g.DirectDependenciesOf(typeName)
+
+	for _, dependency := range dependencies {
+		if _, ok := failedTypes[dependency]; ok {
+			return dependency
+		}
+	}
+
+	return ""
+}
+
+// sweepConcurrently calls f for each index in [0, n) with at most limit concurrent calls.
+// A limit of zero means unlimited concurrency.
+// If the context is done while waiting to call f, the remaining indices fail with the context's error.
+func sweepConcurrently(ctx context.Context, n, limit int, f func(context.Context, int) (ReportEntry, error)) ([]ReportEntry, []error) {
+	entries := make([]ReportEntry, n)
+	errs := make([]error, n)
+
+	var sem chan struct{}
+	if limit > 0 {
+		sem = make(chan struct{}, limit)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		i := i
+
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			for ; i < n; i++ {
+				errs[i] = err
+				entries[i] = ReportEntry{Outcome: OutcomeFailed, Reason: err.Error()}
+			}
+
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+
+			entries[i], errs[i] = f(ctx, i)
+		}()
+	}
+
+	wg.Wait()
+
+	return entries, errs
+}
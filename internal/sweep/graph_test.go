@@ -0,0 +1,497 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestGraphWaves(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph()
+
+	if err := g.AddResourceType("aws_vpc", "aws_security_group", "aws_network_interface", "aws_subnet"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddResourceType("aws_subnet", "aws_network_interface"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddResourceType("aws_security_group", "aws_network_interface"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	g.SetConcurrency("aws_subnet", 5)
+	g.SetConcurrency("aws_security_group", 2)
+
+	got, err := g.Waves()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Wave{
+		{Types: []string{"aws_network_interface"}},
+		{Types: []string{"aws_security_group", "aws_subnet"}, Concurrency: 2},
+		{Types: []string{"aws_vpc"}},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestGraphWavesCycle(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph()
+
+	if err := g.AddResourceType("aws_vpc", "aws_subnet"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddResourceType("aws_subnet", "aws_vpc"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := g.Waves(); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	err := SweepOrchestratorWithOptions(context.Background(), nil, OrchestratorOptions{Graph: g})
+
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_network_interface": {Name: "aws_network_interface"},
+		"aws_subnet":            {Name: "aws_subnet", Dependencies: []string{"aws_network_interface"}},
+		"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+	}
+
+	g, err := sweeperGraph(sweepers)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := g.Waves()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Wave{
+		{Types: []string{"aws_internet_gateway", "aws_network_interface"}},
+		{Types: []string{"aws_subnet"}},
+		{Types: []string{"aws_vpc"}},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	sweepers["aws_network_interface"].Dependencies = []string{"aws_vpc"}
+
+	if _, err := sweeperGraph(sweepers); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestSweepOrchestratorWithGraphContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g := NewGraph()
+	if err := g.AddResourceType("aws_eip"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	g.SetConcurrency("aws_eip", 1)
+
+	sweepables := make([]Sweepable, 0)
+	for i := 0; i < 3; i++ {
+		sweepables = append(sweepables, &mockSweepable{metadata: ResourceMetadata{Type: "aws_eip", ID: fmt.Sprintf("eipalloc-%d", i)}, onDelete: cancel})
+	}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Graph:       g,
+		Report:      NewReport(&buf),
+		WaveRetries: 2,
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- SweepOrchestratorWithOptions(ctx, sweepables, opts)
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context canceled error, got: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for sweep to finish")
+	}
+
+	deleted := 0
+	for _, sweepable := range sweepables {
+		deleted += int(sweepable.(*mockSweepable).deleted)
+	}
+
+	if got, expected := deleted, 1; got != expected {
+		t.Errorf("got %d deletions, expected %d", got, expected)
+	}
+
+	outcomes := reportOutcomes(t, &buf)
+	expected := map[string]Outcome{
+		"eipalloc-0": OutcomeDeleted,
+		"eipalloc-1": OutcomeFailed,
+		"eipalloc-2": OutcomeFailed,
+	}
+
+	if diff := cmp.Diff(outcomes, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorWithGraphOrder(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	order := make([]string, 0)
+	sweepable := func(typeName, id string) *mockSweepable {
+		return &mockSweepable{
+			metadata: ResourceMetadata{Type: typeName, ID: id},
+			onDelete: func() {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, typeName)
+			},
+		}
+	}
+
+	g := NewGraph()
+	if err := g.AddResourceType("aws_vpc", "aws_network_interface"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	sweepables := []Sweepable{
+		sweepable("aws_vpc", "vpc-1"),
+		sweepable("aws_network_interface", "eni-1"),
+		sweepable("aws_network_interface", "eni-2"),
+	}
+
+	if err := SweepOrchestratorWithOptions(context.Background(), sweepables, OrchestratorOptions{Graph: g}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"aws_network_interface", "aws_network_interface", "aws_vpc"}
+
+	if diff := cmp.Diff(order, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweepOrchestratorWithGraphConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var current, max int32
+	onDelete := func() {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	g := NewGraph()
+	if err := g.AddResourceType("aws_eip"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	g.SetConcurrency("aws_eip", 2)
+
+	sweepables := make([]Sweepable, 0)
+	for i := 0; i < 10; i++ {
+		sweepables = append(sweepables, &mockSweepable{metadata: ResourceMetadata{Type: "aws_eip"}, onDelete: onDelete})
+	}
+
+	if err := SweepOrchestratorWithOptions(context.Background(), sweepables, OrchestratorOptions{Graph: g}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, limit := atomic.LoadInt32(&max), int32(2); got > limit {
+		t.Errorf("got %d concurrent deletions, expected at most %d", got, limit)
+	}
+}
+
+func TestSweepOrchestratorWithGraphFailures(t *testing.T) {
+	t.Parallel()
+
+	g := NewGraph()
+	if err := g.AddResourceType("aws_vpc", "aws_subnet", "aws_security_group"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddResourceType("aws_subnet", "aws_network_interface"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := g.AddResourceType("aws_security_group", "aws_instance"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	errInUse := errors.New("DependencyViolation")
+	// Fails once, then succeeds on retry.
+	eni := &mockSweepable{metadata: ResourceMetadata{Type: "aws_network_interface", ID: "eni-1"}, err: errInUse, failures: 1}
+	subnet := &mockSweepable{metadata: ResourceMetadata{Type: "aws_subnet", ID: "subnet-1"}}
+	// Always fails.
+	instance := &mockSweepable{metadata: ResourceMetadata{Type: "aws_instance", ID: "i-1"}, err: errInUse}
+	sg := &mockSweepable{metadata: ResourceMetadata{Type: "aws_security_group", ID: "sg-1"}}
+	vpc := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-1"}}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Graph:       g,
+		Report:      NewReport(&buf),
+		WaveRetries: 2,
+	}
+
+	err := SweepOrchestratorWithOptions(context.Background(), []Sweepable{vpc, sg, instance, subnet, eni}, opts)
+
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if got, expected := eni.deleted, int32(2); got != expected {
+		t.Errorf("network interface deleted %d times, expected %d", got, expected)
+	}
+	if got, expected := instance.deleted, int32(3); got != expected {
+		t.Errorf("instance deleted %d times, expected %d", got, expected)
+	}
+	if got := sg.deleted; got != 0 {
+		t.Errorf("security group deleted %d times", got)
+	}
+	if got := vpc.deleted; got != 0 {
+		t.Errorf("VPC deleted %d times", got)
+	}
+
+	outcomes := reportOutcomes(t, &buf)
+	expected := map[string]Outcome{
+		"eni-1":    OutcomeDeleted,
+		"i-1":      OutcomeFailed,
+		"subnet-1": OutcomeDeleted,
+		"sg-1":     OutcomeSkipped,
+		"vpc-1":    OutcomeSkipped,
+	}
+
+	if diff := cmp.Diff(outcomes, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRunSweepersSkipsDependents(t *testing.T) {
+	t.Parallel()
+
+	errInUse := errors.New("DependencyViolation")
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	sweeper := func(name string, err error, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(region string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				calls[name]++
+
+				return err
+			},
+		}
+	}
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_network_interface": sweeper("aws_network_interface", errInUse),
+		"aws_security_group":    sweeper("aws_security_group", nil, "aws_network_interface"),
+		"aws_subnet":            sweeper("aws_subnet", nil),
+		"aws_vpc":               sweeper("aws_vpc", nil, "aws_security_group", "aws_subnet"),
+	}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Report:      NewReport(&buf),
+		WaveRetries: 1,
+	}
+
+	if err := runSweepers("us-west-2", sweepers, true, opts); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	expected := map[string]int{
+		"aws_network_interface": 2,
+		"aws_subnet":            1,
+	}
+
+	if diff := cmp.Diff(calls, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	entries := make(map[string]Outcome)
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry ReportEntry
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("decoding report: %s", err)
+		}
+		entries[entry.Type] = entry.Outcome
+	}
+
+	expectedEntries := map[string]Outcome{
+		"aws_security_group": OutcomeSkipped,
+		"aws_vpc":            OutcomeSkipped,
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRunSweepersStopsOnFailure(t *testing.T) {
+	t.Parallel()
+
+	ran := false
+	sweepers := map[string]*resource.Sweeper{
+		"aws_network_interface": {Name: "aws_network_interface", F: func(string) error { return errors.New("DependencyViolation") }},
+		"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_network_interface"}, F: func(string) error { ran = true; return nil }},
+	}
+
+	if err := runSweepers("us-west-2", sweepers, false, OrchestratorOptions{}); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if ran {
+		t.Errorf("dependent sweeper ran")
+	}
+}
+
+func TestRunSweepersConcurrency(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		concurrency map[string]int
+		expected    int32
+	}{
+		"no limit": {
+			expected: 1,
+		},
+		"limit": {
+			concurrency: map[string]int{"aws_eip": 3, "aws_instance": 5},
+			expected:    3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var current, max int32
+			f := func(string) error {
+				n := atomic.AddInt32(&current, 1)
+				defer atomic.AddInt32(&current, -1)
+
+				for {
+					m := atomic.LoadInt32(&max)
+					if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(20 * time.Millisecond)
+
+				return nil
+			}
+
+			sweepers := make(map[string]*resource.Sweeper)
+			for _, name := range []string{"aws_eip", "aws_instance", "aws_key_pair", "aws_launch_template", "aws_placement_group", "aws_subnet"} {
+				sweepers[name] = &resource.Sweeper{Name: name, F: f}
+			}
+
+			if err := runSweepers("us-west-2", sweepers, false, OrchestratorOptions{Concurrency: testCase.concurrency}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := atomic.LoadInt32(&max); got != testCase.expected {
+				t.Errorf("got %d concurrent sweepers, expected %d", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestParseConcurrency(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseConcurrency("aws_instance=5, aws_vpc=2")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, map[string]int{"aws_instance": 5, "aws_vpc": 2}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	for _, v := range []string{"aws_instance", "aws_instance=0", "aws_instance=x", "=5"} {
+		if _, err := parseConcurrency(v); err == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+func TestRunningSweeperName(t *testing.T) {
+	addRunningSweeper("aws_eip")
+
+	if got, expected := runningSweeperName(), "aws_eip"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	addRunningSweeper("aws_instance")
+
+	if got := runningSweeperName(); got != "" {
+		t.Errorf("got %q with concurrent sweepers, expected no name", got)
+	}
+
+	removeRunningSweeper("aws_eip")
+
+	if got, expected := runningSweeperName(), "aws_instance"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+
+	removeRunningSweeper("aws_instance")
+
+	if got := runningSweeperName(); got != "" {
+		t.Errorf("got %q, expected no name", got)
+	}
+}
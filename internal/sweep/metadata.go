@@ -0,0 +1,77 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"time"
+)
+
+// ResourceMetadata describes a resource that is a candidate for sweeping.
+// Only ID is guaranteed to be set, other fields are populated when known.
+// Tags is nil if the resource's tags are unknown and empty if it is known to have none.
+// Type defaults to the name of the running sweeper, see AddTestSweepers.
+type ResourceMetadata struct {
+	Type      string            `json:"type,omitempty"`
+	ID        string            `json:"id,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+// Describable is implemented by Sweepables that can report metadata about the resource they delete.
+type Describable interface {
+	Metadata(ctx context.Context) ResourceMetadata
+}
+
+// MetadataFunc sets additional metadata on a sweepable resource.
+// Sweepers use these to provide information not available from the resource's schema, e.g. creation time.
+type MetadataFunc func(*ResourceMetadata)
+
+func WithType(typeName string) MetadataFunc {
+	return func(md *ResourceMetadata) {
+		md.Type = typeName
+	}
+}
+
+func WithName(name string) MetadataFunc {
+	return func(md *ResourceMetadata) {
+		md.Name = name
+	}
+}
+
+// WithCreatedAt sets the resource's creation time, if known.
+func WithCreatedAt(createdAt *time.Time) MetadataFunc {
+	return func(md *ResourceMetadata) {
+		if createdAt != nil {
+			v := *createdAt
+			md.CreatedAt = &v
+		}
+	}
+}
+
+// WithTags sets the resource's tags. A nil map means that the resource has no tags.
+func WithTags(tags map[string]string) MetadataFunc {
+	return func(md *ResourceMetadata) {
+		md.Tags = tags
+
+		if md.Tags == nil {
+			md.Tags = make(map[string]string)
+		}
+	}
+}
+
+func describe(ctx context.Context, sweepable Sweepable) ResourceMetadata {
+	var md ResourceMetadata
+
+	if v, ok := sweepable.(Describable); ok {
+		md = v.Metadata(ctx)
+	}
+
+	if md.Type == "" {
+		md.Type = runningSweeperName()
+	}
+
+	return md
+}
@@ -0,0 +1,230 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// OrchestratorOptions controls how sweepables are processed.
+type OrchestratorOptions struct {
+	// DryRun lists each candidate resource without deleting it.
+	DryRun bool
+
+	// Filters determine which resources are kept.
+	Filters []Filter
+
+	// Report, if set, records the outcome for each resource.
+	Report *Report
+
+	// Graph, if set, orders deletion by resource type.
+	// Resources are deleted in waves, see Graph.Waves.
+	Graph *Graph
+
+	// WaveRetries is the number of times failed deletions in a wave, or failed sweepers, are retried
+	// before resources that depend on them are skipped.
+	WaveRetries int
+
+	// WaveRetryDelay is the time to wait before retrying failed deletions in a wave.
+	WaveRetryDelay time.Duration
+
+	// Concurrency limits, by resource type, the number of concurrent deletions, and of concurrently running
+	// sweepers, in the wave containing the type, see Graph.SetConcurrency.
+	Concurrency map[string]int
+}
+
+func (opts OrchestratorOptions) record(entry ReportEntry) {
+	if err := opts.Report.Record(entry); err != nil {
+		log.Printf("[WARN] Writing sweep report: %s", err)
+	}
+}
+
+const defaultWaveRetryDelay = 30 * time.Second
+
+var (
+	envOrchestratorOptions     OrchestratorOptions
+	envOrchestratorOptionsErr  error
+	envOrchestratorOptionsOnce sync.Once
+)
+
+// OrchestratorOptionsFromEnv returns the orchestrator options configured via environment variables.
+// The returned options' Graph orders deletion by the dependencies of the sweepers registered with AddTestSweepers.
+// The options are read once per process so that all sweepers share a single report file.
+func OrchestratorOptionsFromEnv() (OrchestratorOptions, error) {
+	envOrchestratorOptionsOnce.Do(func() {
+		envOrchestratorOptions, envOrchestratorOptionsErr = orchestratorOptionsFromEnv()
+	})
+
+	return envOrchestratorOptions, envOrchestratorOptionsErr
+}
+
+func orchestratorOptionsFromEnv() (OrchestratorOptions, error) {
+	var opts OrchestratorOptions
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		opts.DryRun = dryRun
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		opts.Filters = append(opts.Filters, KeepYoungerThan(minAge))
+	}
+
+	if v := os.Getenv(envvar.SweepKeepPrefixes); v != "" {
+		opts.Filters = append(opts.Filters, KeepNamePrefixes(strings.Split(v, ",")...))
+	}
+
+	if v := os.Getenv(envvar.SweepKeepTag); v != "" {
+		key, value, _ := strings.Cut(v, "=")
+		opts.Filters = append(opts.Filters, KeepTagged(key, value))
+	}
+
+	if v := os.Getenv(envvar.SweepRetries); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepRetries, err)
+		}
+		opts.WaveRetries = retries
+		opts.WaveRetryDelay = defaultWaveRetryDelay
+	}
+
+	if v := os.Getenv(envvar.SweepReportFile); v != "" {
+		report, err := NewFileReport(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepReportFile, err)
+		}
+		opts.Report = report
+	}
+
+	if v := os.Getenv(envvar.SweepConcurrency); v != "" {
+		concurrency, err := parseConcurrency(v)
+		if err != nil {
+			return opts, fmt.Errorf("environment variable %s: %w", envvar.SweepConcurrency, err)
+		}
+		opts.Concurrency = concurrency
+	}
+
+	if len(sweepers) > 0 {
+		graph, err := sweeperGraph(sweepers)
+		if err != nil {
+			return opts, fmt.Errorf("sweeper dependencies: %w", err)
+		}
+		for typeName, n := range opts.Concurrency {
+			graph.SetConcurrency(typeName, n)
+		}
+		opts.Graph = graph
+	}
+
+	return opts, nil
+}
+
+// parseConcurrency parses a comma-separated list of resource type=limit pairs.
+func parseConcurrency(s string) (map[string]int, error) {
+	concurrency := make(map[string]int)
+
+	for _, v := range strings.Split(s, ",") {
+		typeName, limit, ok := strings.Cut(strings.TrimSpace(v), "=")
+
+		if !ok || typeName == "" {
+			return nil, fmt.Errorf("%q is not a resource type=limit pair", v)
+		}
+
+		n, err := strconv.Atoi(limit)
+
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("limit for %s (%s) is not a positive integer", typeName, limit)
+		}
+
+		concurrency[typeName] = n
+	}
+
+	return concurrency, nil
+}
+
+func SweepOrchestrator(sweepables []Sweepable) error {
+	return SweepOrchestratorWithContext(context.Background(), sweepables)
+}
+
+// SweepOrchestratorWithContext deletes the specified sweepables concurrently,
+// honoring the orchestrator options configured via environment variables.
+func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts, err := OrchestratorOptionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	return SweepOrchestratorWithOptions(ctx, sweepables, opts, optFns...)
+}
+
+// SweepOrchestratorWithOptions deletes the specified sweepables concurrently, or in dependency order if opts.Graph is set.
+// Resources matching any filter are kept and, in dry-run mode, no resource is deleted.
+// Deletion errors for which SkipSweepError returns true are reported as skipped but, as for any other
+// deletion error, are returned so that the sweeper can decide how to handle them.
+func SweepOrchestratorWithOptions(ctx context.Context, sweepables []Sweepable, opts OrchestratorOptions, optFns ...tfresource.OptionsFunc) error {
+	if opts.Graph != nil {
+		return sweepInWaves(ctx, sweepables, opts, optFns...)
+	}
+
+	var g multierror.Group
+
+	for _, sweepable := range sweepables {
+		sweepable := sweepable
+
+		g.Go(func() error {
+			entry, err := sweepOne(ctx, sweepable, describe(ctx, sweepable), opts, optFns...)
+			opts.record(entry)
+
+			return err
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// sweepOne deletes a single sweepable, unless it is kept by a filter or in dry-run mode, and returns the outcome.
+func sweepOne(ctx context.Context, sweepable Sweepable, md ResourceMetadata, opts OrchestratorOptions, optFns ...tfresource.OptionsFunc) (ReportEntry, error) {
+	entry := ReportEntry{ResourceMetadata: md}
+	var err error
+
+	if ok, reason := keep(md, opts.Filters); ok {
+		log.Printf("[INFO] Keeping %s (%s): %s", md.Type, md.ID, reason)
+		entry.Outcome, entry.Reason = OutcomeSkipped, reason
+	} else if opts.DryRun {
+		log.Printf("[INFO] Dry run, not deleting %s (%s)", md.Type, md.ID)
+		entry.Outcome = OutcomeDryRun
+	} else {
+		err = sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+
+		switch {
+		case err == nil:
+			entry.Outcome = OutcomeDeleted
+		case SkipSweepError(err):
+			log.Printf("[WARN] Skipping %s (%s): %s", md.Type, md.ID, err)
+			entry.Outcome, entry.Reason = OutcomeSkipped, err.Error()
+		default:
+			entry.Outcome, entry.Reason = OutcomeFailed, err.Error()
+		}
+	}
+
+	return entry, err
+}
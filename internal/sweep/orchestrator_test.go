@@ -0,0 +1,412 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockSweepable struct {
+	metadata ResourceMetadata
+	err      error
+	failures int32 // If non-zero, Delete only returns err this many times
+	deleted  int32
+	onDelete func()
+}
+
+func (m *mockSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	n := atomic.AddInt32(&m.deleted, 1)
+
+	if m.onDelete != nil {
+		m.onDelete()
+	}
+
+	if m.failures > 0 && n > m.failures {
+		return nil
+	}
+
+	return m.err
+}
+
+func (m *mockSweepable) Metadata(_ context.Context) ResourceMetadata {
+	return m.metadata
+}
+
+func TestFilters(t *testing.T) {
+	current := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = time.Now })
+
+	young := current.Add(-1 * time.Hour)
+	old := current.Add(-48 * time.Hour)
+
+	testCases := []struct {
+		TestName string
+		Filter   Filter
+		Metadata ResourceMetadata
+		Expected bool
+	}{
+		{
+			TestName: "young",
+			Filter:   KeepYoungerThan(24 * time.Hour),
+			Metadata: ResourceMetadata{ID: "i-1", CreatedAt: &young},
+			Expected: true,
+		},
+		{
+			TestName: "old",
+			Filter:   KeepYoungerThan(24 * time.Hour),
+			Metadata: ResourceMetadata{ID: "i-1", CreatedAt: &old},
+		},
+		{
+			TestName: "unknown age",
+			Filter:   KeepYoungerThan(24 * time.Hour),
+			Metadata: ResourceMetadata{ID: "i-1"},
+			Expected: true,
+		},
+		{
+			TestName: "protected name",
+			Filter:   KeepNamePrefixes("fixture-", "shared-"),
+			Metadata: ResourceMetadata{ID: "i-1", Name: "shared-vpc"},
+			Expected: true,
+		},
+		{
+			TestName: "protected ID",
+			Filter:   KeepNamePrefixes("fixture-"),
+			Metadata: ResourceMetadata{ID: "fixture-1"},
+			Expected: true,
+		},
+		{
+			TestName: "unprotected name",
+			Filter:   KeepNamePrefixes("fixture-", ""),
+			Metadata: ResourceMetadata{ID: "fixture-1", Name: "tf-acc-test-1"},
+		},
+		{
+			TestName: "unknown name",
+			Filter:   KeepNamePrefixes("fixture-"),
+			Metadata: ResourceMetadata{ID: "i-1"},
+			Expected: true,
+		},
+		{
+			TestName: "keep tag key",
+			Filter:   KeepTagged("keep", ""),
+			Metadata: ResourceMetadata{ID: "i-1", Tags: map[string]string{"keep": "no"}},
+			Expected: true,
+		},
+		{
+			TestName: "keep tag value",
+			Filter:   KeepTagged("keep", "yes"),
+			Metadata: ResourceMetadata{ID: "i-1", Tags: map[string]string{"keep": "yes"}},
+			Expected: true,
+		},
+		{
+			TestName: "keep tag value mismatch",
+			Filter:   KeepTagged("keep", "yes"),
+			Metadata: ResourceMetadata{ID: "i-1", Tags: map[string]string{"keep": "no"}},
+		},
+		{
+			TestName: "untagged",
+			Filter:   KeepTagged("keep", ""),
+			Metadata: ResourceMetadata{ID: "i-1", Tags: map[string]string{}},
+		},
+		{
+			TestName: "unknown tags",
+			Filter:   KeepTagged("keep", ""),
+			Metadata: ResourceMetadata{ID: "i-1"},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, reason := testCase.Filter(testCase.Metadata)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+
+			if got && reason == "" {
+				t.Errorf("expected reason")
+			}
+		})
+	}
+}
+
+func TestSweepOrchestratorWithOptions(t *testing.T) {
+	t.Parallel()
+
+	kept := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-1", Tags: map[string]string{"keep": "true"}}}
+	deleted := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-2", Tags: map[string]string{}}}
+	skipped := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-3", Tags: map[string]string{}}, err: &mockAPIError{code: "UnsupportedOperation"}}
+	failed := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-4", Tags: map[string]string{}}, err: errors.New("DependencyViolation")}
+	unknown := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-5"}}
+	sweepables := []Sweepable{kept, deleted, skipped, failed, unknown}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Filters: []Filter{KeepTagged("keep", "")},
+		Report:  NewReport(&buf),
+	}
+
+	err := SweepOrchestratorWithOptions(context.Background(), sweepables, opts)
+
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if got := kept.deleted; got != 0 {
+		t.Errorf("kept resource deleted %d times", got)
+	}
+
+	if got := unknown.deleted; got != 0 {
+		t.Errorf("resource with unknown tags deleted %d times", got)
+	}
+
+	outcomes := reportOutcomes(t, &buf)
+	expected := map[string]Outcome{
+		"vpc-1": OutcomeSkipped,
+		"vpc-2": OutcomeDeleted,
+		"vpc-3": OutcomeSkipped,
+		"vpc-4": OutcomeFailed,
+		"vpc-5": OutcomeSkipped,
+	}
+
+	for id, outcome := range expected {
+		if got := outcomes[id]; got != outcome {
+			t.Errorf("%s: got outcome %q, expected %q", id, got, outcome)
+		}
+	}
+}
+
+func TestSweepOrchestratorWithOptionsDryRun(t *testing.T) {
+	t.Parallel()
+
+	sweepable := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-1"}}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		DryRun: true,
+		Report: NewReport(&buf),
+	}
+
+	if err := SweepOrchestratorWithOptions(context.Background(), []Sweepable{sweepable}, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := sweepable.deleted; got != 0 {
+		t.Errorf("resource deleted %d times in dry run", got)
+	}
+
+	if got, expected := reportOutcomes(t, &buf)["vpc-1"], OutcomeDryRun; got != expected {
+		t.Errorf("got outcome %q, expected %q", got, expected)
+	}
+}
+
+func TestSweepOrchestratorWithOptionsSkipError(t *testing.T) {
+	t.Parallel()
+
+	sweepable := &mockSweepable{metadata: ResourceMetadata{Type: "aws_vpc", ID: "vpc-1"}, err: &mockAPIError{code: "UnsupportedOperation"}}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Report: NewReport(&buf),
+	}
+
+	err := SweepOrchestratorWithOptions(context.Background(), []Sweepable{sweepable}, opts)
+
+	if !SkipSweepError(err) {
+		t.Fatalf("expected skip sweep error, got: %v", err)
+	}
+
+	if got, expected := reportOutcomes(t, &buf)["vpc-1"], OutcomeSkipped; got != expected {
+		t.Errorf("got outcome %q, expected %q", got, expected)
+	}
+}
+
+func TestDeleteResourceWithOptions(t *testing.T) {
+	t.Parallel()
+
+	kept := &mockSweepable{metadata: ResourceMetadata{Type: "aws_iam_role", ID: "keep-role", Name: "keep-role"}}
+	deleted := &mockSweepable{metadata: ResourceMetadata{Type: "aws_iam_role", ID: "test-role", Name: "test-role"}}
+	previewed := &mockSweepable{metadata: ResourceMetadata{Type: "aws_iam_role", ID: "other-role", Name: "other-role"}}
+
+	var buf bytes.Buffer
+	opts := OrchestratorOptions{
+		Filters: []Filter{KeepNamePrefixes("keep-")},
+		Report:  NewReport(&buf),
+	}
+
+	for _, sweepable := range []*mockSweepable{kept, deleted} {
+		if err := deleteResourceWithOptions(context.Background(), sweepable, opts); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	opts.DryRun = true
+
+	if err := deleteResourceWithOptions(context.Background(), previewed, opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := kept.deleted; got != 0 {
+		t.Errorf("kept resource deleted %d times", got)
+	}
+
+	if got := deleted.deleted; got != 1 {
+		t.Errorf("resource deleted %d times, expected 1", got)
+	}
+
+	if got := previewed.deleted; got != 0 {
+		t.Errorf("resource deleted %d times in dry run", got)
+	}
+
+	outcomes := reportOutcomes(t, &buf)
+	expected := map[string]Outcome{
+		"keep-role":  OutcomeSkipped,
+		"test-role":  OutcomeDeleted,
+		"other-role": OutcomeDryRun,
+	}
+
+	for id, outcome := range expected {
+		if got := outcomes[id]; got != outcome {
+			t.Errorf("%s: got outcome %q, expected %q", id, got, outcome)
+		}
+	}
+}
+
+func TestCheckDirectDeletion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts        OrchestratorOptions
+		expectError bool
+	}{
+		"no options": {},
+		"retries": {
+			opts: OrchestratorOptions{WaveRetries: 1},
+		},
+		"dry run": {
+			opts:        OrchestratorOptions{DryRun: true},
+			expectError: true,
+		},
+		"filters": {
+			opts:        OrchestratorOptions{Filters: []Filter{KeepTagged("keep", "")}},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			opts := testCase.opts
+			opts.Report = NewReport(&buf)
+
+			err := checkDirectDeletion(opts, "aws_redshift_cluster")
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+
+				var entry ReportEntry
+				if err := json.NewDecoder(&buf).Decode(&entry); err != nil {
+					t.Fatalf("decoding report: %s", err)
+				}
+
+				if got, expected := entry.Type, "aws_redshift_cluster"; got != expected {
+					t.Errorf("got type %q, expected %q", got, expected)
+				}
+
+				if got, expected := entry.Outcome, OutcomeFailed; got != expected {
+					t.Errorf("got outcome %q, expected %q", got, expected)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if buf.Len() != 0 {
+				t.Errorf("unexpected report entries: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestFileReport(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "report.jsonl")
+
+	for _, id := range []string{"vpc-1", "vpc-2"} {
+		report, err := NewFileReport(path)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := report.Record(ReportEntry{ResourceMetadata: ResourceMetadata{Type: "aws_vpc", ID: id}, Outcome: OutcomeDeleted}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	outcomes := reportOutcomes(t, bytes.NewBuffer(b))
+
+	if got, expected := len(outcomes), 2; got != expected {
+		t.Errorf("got %d entries, expected %d", got, expected)
+	}
+}
+
+func reportOutcomes(t *testing.T, buf *bytes.Buffer) map[string]Outcome {
+	t.Helper()
+
+	outcomes := make(map[string]Outcome)
+	dec := json.NewDecoder(buf)
+
+	for dec.More() {
+		var entry ReportEntry
+
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("decoding report: %s", err)
+		}
+
+		outcomes[entry.ID] = entry.Outcome
+	}
+
+	return outcomes
+}
+
+type mockAPIError struct {
+	code string
+}
+
+func (e *mockAPIError) Error() string     { return e.code }
+func (e *mockAPIError) Code() string      { return e.code }
+func (e *mockAPIError) Message() string   { return "" }
+func (e *mockAPIError) OrigErr() error    { return nil }
+func (e *mockAPIError) StatusCode() int   { return 400 }
+func (e *mockAPIError) RequestID() string { return "" }
@@ -0,0 +1,68 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var (
+	// sweepers are the sweepers registered with AddTestSweepers, by name.
+	sweepers = make(map[string]*resource.Sweeper)
+
+	runningSweepersMu sync.Mutex
+	runningSweepers   = make(map[string]int)
+)
+
+// AddTestSweepers registers a sweeper with the Plugin SDK, see resource.AddTestSweepers.
+// A sweeper is named after the type of the resources it sweeps. While it runs, resources swept
+// without an explicit type, see WithType, are attributed to that type.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	f := s.F
+	s.F = func(region string) error {
+		addRunningSweeper(name)
+		defer removeRunningSweeper(name)
+
+		return f(region)
+	}
+
+	sweepers[name] = s
+	resource.AddTestSweepers(name, s)
+}
+
+// runningSweeperName returns the name of the running sweeper, if any.
+// If several sweepers are running concurrently, see runSweepers, the resources being swept can't be attributed
+// to any one of them and an empty name is returned.
+func runningSweeperName() string {
+	runningSweepersMu.Lock()
+	defer runningSweepersMu.Unlock()
+
+	if len(runningSweepers) != 1 {
+		return ""
+	}
+
+	for name := range runningSweepers {
+		return name
+	}
+
+	return ""
+}
+
+func addRunningSweeper(name string) {
+	runningSweepersMu.Lock()
+	defer runningSweepersMu.Unlock()
+
+	runningSweepers[name]++
+}
+
+func removeRunningSweeper(name string) {
+	runningSweepersMu.Lock()
+	defer runningSweepersMu.Unlock()
+
+	if runningSweepers[name]--; runningSweepers[name] <= 0 {
+		delete(runningSweepers, name)
+	}
+}
@@ -0,0 +1,93 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Outcome is the result of sweeping a single resource.
+type Outcome string
+
+const (
+	OutcomeDeleted Outcome = "deleted"
+	OutcomeDryRun  Outcome = "dry-run"
+	OutcomeFailed  Outcome = "failed"
+	OutcomeSkipped Outcome = "skipped"
+)
+
+// ReportEntry records the outcome of sweeping a single resource.
+type ReportEntry struct {
+	ResourceMetadata
+	Outcome Outcome   `json:"outcome"`
+	Reason  string    `json:"reason,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// Report writes sweep outcomes as JSON Lines, one object per resource.
+// A nil Report discards all entries.
+type Report struct {
+	mu   sync.Mutex
+	enc  *json.Encoder
+	path string
+}
+
+func NewReport(w io.Writer) *Report {
+	return &Report{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// NewFileReport returns a Report that appends entries to the file at path, creating it if necessary.
+// The file is opened for each entry, so no file is left open when the sweeper process exits.
+func NewFileReport(path string) (*Report, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		path: path,
+	}, nil
+}
+
+// Record writes the specified entry to the report.
+func (r *Report) Record(entry ReportEntry) error {
+	if r == nil {
+		return nil
+	}
+
+	if entry.Time.IsZero() {
+		entry.Time = now().UTC()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.path == "" {
+		return r.enc.Encode(entry)
+	}
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(f).Encode(entry); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
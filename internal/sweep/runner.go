@@ -0,0 +1,233 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise it runs the tests.
+// It accepts the same flags as resource.TestMain, but sweepers run in dependency-ordered waves
+// across all registered sweepers, see runSweepers.
+func TestMain(m interface{ Run() int }) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	opts, err := OrchestratorOptionsFromEnv()
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	toRun := filterSweepers(flagValue("sweep-run"), sweepers)
+	allowFailures := flagValue("sweep-allow-failures") == "true"
+
+	for _, region := range strings.Split(regions, ",") {
+		if err := runSweepers(strings.TrimSpace(region), toRun, allowFailures, opts); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated filters,
+// case-insensitively, together with their dependencies. An empty filter returns all sweepers.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		s, ok := source[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, v := range strings.Split(strings.ToLower(filter), ",") {
+			if v != "" && strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// runSweepers runs the specified sweepers in a region, one dependency wave at a time, see Graph.Waves.
+// A failed sweeper is retried up to opts.WaveRetries times once the rest of its wave has run.
+// Sweepers that depend, directly or through a skipped sweeper, on one that still fails are skipped.
+// Unless allowFailures is true, the first failure stops the run once the rest of its wave has run.
+// Sweepers within a wave run concurrently up to the wave's limit, see OrchestratorOptions.Concurrency, or one at a time
+// if it has none, so that by default swept resources are attributed to the running sweeper.
+func runSweepers(region string, sweepers map[string]*resource.Sweeper, allowFailures bool, opts OrchestratorOptions) error {
+	g, err := sweeperGraph(sweepers)
+
+	if err != nil {
+		return fmt.Errorf("sweeper dependencies: %w", err)
+	}
+
+	for typeName, n := range opts.Concurrency {
+		g.SetConcurrency(typeName, n)
+	}
+
+	waves, err := g.Waves()
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+	start := time.Now()
+
+	results := make(map[string]error)
+	failedTypes := make(map[string]struct{})
+
+	for i, wave := range waves {
+		pending := make([]*resource.Sweeper, 0)
+
+		for _, name := range wave.Types {
+			s, ok := sweepers[name]
+
+			if !ok {
+				continue
+			}
+
+			if dependency := failedDependency(g.g, name, failedTypes); dependency != "" {
+				reason := fmt.Sprintf("dependency %s was not swept", dependency)
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, reason)
+				opts.record(ReportEntry{ResourceMetadata: ResourceMetadata{Type: name}, Outcome: OutcomeSkipped, Reason: reason})
+
+				failedTypes[name] = struct{}{}
+				results[name] = fmt.Errorf("skipped: %s", reason)
+
+				continue
+			}
+
+			pending = append(pending, s)
+		}
+
+		for attempt := 0; len(pending) > 0; attempt++ {
+			if attempt > 0 {
+				log.Printf("[INFO] Retrying %d failed Sweepers in wave %d for region (%s) (attempt %d)", len(pending), i, region, attempt+1)
+				time.Sleep(opts.WaveRetryDelay)
+			}
+
+			failed := make([]*resource.Sweeper, 0)
+			errs := runSweeperWave(region, pending, wave.Concurrency)
+
+			for j, s := range pending {
+				err := errs[j]
+				results[s.Name] = err
+
+				if err == nil {
+					continue
+				}
+
+				if attempt < opts.WaveRetries {
+					failed = append(failed, s)
+					continue
+				}
+
+				log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", s.Name, region, err)
+				failedTypes[s.Name] = struct{}{}
+
+				if !allowFailures {
+					return fmt.Errorf("sweeper (%s) for region (%s) failed: %w", s.Name, region, err)
+				}
+			}
+
+			pending = failed
+		}
+	}
+
+	log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	var failures []string
+	for name, err := range results {
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", name, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		sort.Strings(failures)
+
+		return fmt.Errorf("%d sweepers for region (%s) failed or were skipped:\n\t%s", len(failures), region, strings.Join(failures, "\n\t"))
+	}
+
+	return nil
+}
+
+// runSweeperWave runs the specified sweepers, at most concurrency at a time or one at a time if concurrency is zero,
+// and returns each sweeper's error.
+func runSweeperWave(region string, sweepers []*resource.Sweeper, concurrency int) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(sweepers))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, s := range sweepers {
+		i, s := i, s
+
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+			errs[i] = s.F(region)
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
@@ -16,12 +16,12 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -104,22 +104,51 @@ type Sweepable interface {
 }
 
 type SweepResource struct {
-	d        *schema.ResourceData
-	meta     interface{}
-	resource *schema.Resource
+	d           *schema.ResourceData
+	meta        interface{}
+	metadataFns []MetadataFunc
+	resource    *schema.Resource
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, metadataFns ...MetadataFunc) *SweepResource {
 	return &SweepResource{
-		d:        d,
-		meta:     meta,
-		resource: resource,
+		d:           d,
+		meta:        meta,
+		metadataFns: metadataFns,
+		resource:    resource,
 	}
 }
 
+// Metadata returns the resource's ID, the "name" and "tags" attributes if present in the resource's schema
+// and any additional metadata supplied by the sweeper.
+// Most sweepers only set the resource's ID, in which case its name and tags are unknown, see Filter.
+func (sr *SweepResource) Metadata(_ context.Context) ResourceMetadata {
+	md := ResourceMetadata{
+		ID: sr.d.Id(),
+	}
+
+	if _, ok := sr.resource.Schema["name"]; ok {
+		if v, ok := sr.d.Get("name").(string); ok {
+			md.Name = v
+		}
+	}
+
+	if _, ok := sr.resource.Schema["tags"]; ok {
+		if v, ok := sr.d.Get("tags").(map[string]interface{}); ok && len(v) > 0 {
+			md.Tags = flex.ExpandStringValueMap(v)
+		}
+	}
+
+	for _, fn := range sr.metadataFns {
+		fn(&md)
+	}
+
+	return md
+}
+
 func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	err := tfresource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		err := DeleteResource(sr.resource, sr.d, sr.meta)
+		err := deleteResource(sr.resource, sr.d, sr.meta)
 
 		if err != nil {
 			if strings.Contains(err.Error(), "Throttling") {
@@ -134,31 +163,13 @@ func (sr *SweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	}, optFns...)
 
 	if tfresource.TimedOut(err) {
-		err = DeleteResource(sr.resource, sr.d, sr.meta)
+		err = deleteResource(sr.resource, sr.d, sr.meta)
 	}
 
 	return err
 
 }
 
-func SweepOrchestrator(sweepables []Sweepable) error {
-	return SweepOrchestratorWithContext(context.Background(), sweepables)
-}
-
-func SweepOrchestratorWithContext(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
-	var g multierror.Group
-
-	for _, sweepable := range sweepables {
-		sweepable := sweepable
-
-		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
-		})
-	}
-
-	return g.Wait().ErrorOrNil()
-}
-
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func SkipSweepError(err error) bool {
@@ -235,7 +246,54 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
-func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+// DeleteResource deletes a single resource, unless it is kept by a filter or in dry-run mode, and records the outcome,
+// honoring the orchestrator options configured via environment variables as SweepOrchestrator does.
+// Sweepers that delete resources one at a time, e.g. after deleting their dependents, use it rather than
+// calling the resource's delete function or the AWS API directly.
+func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, metadataFns ...MetadataFunc) error {
+	opts, err := OrchestratorOptionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	return deleteResourceWithOptions(context.Background(), NewSweepResource(resource, d, meta, metadataFns...), opts)
+}
+
+func deleteResourceWithOptions(ctx context.Context, sweepable Sweepable, opts OrchestratorOptions) error {
+	entry, err := sweepOne(ctx, sweepable, describe(ctx, sweepable), opts)
+	opts.record(entry)
+
+	return err
+}
+
+// CheckDirectDeletion returns an error, and records the sweeper as failed, if resources must be kept or previewed,
+// see OrchestratorOptions. Sweepers that delete resources by calling the AWS API directly, rather than with
+// SweepOrchestrator or DeleteResource, can't honor these options. They call CheckDirectDeletion before deleting
+// anything so that a dry run, or a run with keep filters, fails instead of deleting resources.
+func CheckDirectDeletion() error {
+	opts, err := OrchestratorOptionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	return checkDirectDeletion(opts, runningSweeperName())
+}
+
+func checkDirectDeletion(opts OrchestratorOptions, name string) error {
+	if !opts.DryRun && len(opts.Filters) == 0 {
+		return nil
+	}
+
+	err := fmt.Errorf("sweeper (%s) deletes resources directly and can't be previewed or keep resources: unset %s, %s, %s and %s to run it",
+		name, envvar.SweepDryRun, envvar.SweepKeepPrefixes, envvar.SweepKeepTag, envvar.SweepMinAge)
+	opts.record(ReportEntry{ResourceMetadata: ResourceMetadata{Type: name}, Outcome: OutcomeFailed, Reason: err.Error()})
+
+	return err
+}
+
+func deleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
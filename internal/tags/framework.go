@@ -1,12 +1,25 @@
 package tags
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Terraform Plugin Framework variants of tags schemas.
 
+func TagsAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.MapType{ElemType: types.StringType},
+		Optional: true,
+	}
+}
+
 func TagsAttributeComputed() tfsdk.Attribute {
 	return tfsdk.Attribute{
 		Type:     types.MapType{ElemType: types.StringType},
@@ -14,3 +27,99 @@ func TagsAttributeComputed() tfsdk.Attribute {
 		Computed: true,
 	}
 }
+
+// TagsAllAttribute returns the schema to use for "tags_all".
+// Its planned value must be set by calling ModifyPlanTagsAll from the resource's ModifyPlan method.
+func TagsAllAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.MapType{ElemType: types.StringType},
+		Computed: true,
+	}
+}
+
+// Terraform Plugin Framework variants of tags plan modification and flattening.
+
+// PlannedTagsAll returns the value of "tags_all": the merger of the resource's "tags" on to those defined at the provider-level,
+// less any ignored tags. The Framework equivalent of verify.SetTagsDiff.
+// Returns an error diagnostic if the resource tags are identical to those configured at the provider-level
+// as resource and provider-level tags will be indistinguishable when returned from an AWS API.
+func PlannedTagsAll(_ context.Context, tags types.Map, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tags.IsUnknown() {
+		return types.Map{ElemType: types.StringType, Unknown: true}, diags
+	}
+
+	for _, v := range tags.Elems {
+		if v.IsUnknown() {
+			return types.Map{ElemType: types.StringType, Unknown: true}, diags
+		}
+	}
+
+	resourceTags := New(tags)
+
+	if len(resourceTags) > 0 && defaultConfig.TagsEqual(resourceTags) {
+		diags.AddAttributeError(
+			path.Root("tags"),
+			"Invalid tags",
+			`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`,
+		)
+
+		return types.Map{ElemType: types.StringType, Unknown: true}, diags
+	}
+
+	allTags := defaultConfig.MergeTags(resourceTags).IgnoreConfig(ignoreConfig)
+
+	return flattenFrameworkTagsMap(allTags), diags
+}
+
+// ModifyPlanTagsAll sets the planned value of "tags_all" from the planned value of "tags".
+// It is intended to be called from a resource's ModifyPlan method.
+func ModifyPlanTagsAll(ctx context.Context, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Resource is being destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planTags types.Map
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := PlannedTagsAll(ctx, planTags, defaultConfig, ignoreConfig)
+
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// FlattenFrameworkTags returns the values of "tags" and "tags_all" for the specified resource tags read from an AWS API.
+// AWS-internal and ignored tags are removed and "tags" excludes tags inherited from the provider-level.
+// The current value of "tags" (e.g. from configuration or prior state) is used to decide between a null and an empty map.
+func FlattenFrameworkTags(_ context.Context, apiTags KeyValueTags, current types.Map, defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig) (types.Map, types.Map) {
+	allTags := apiTags.IgnoreAWS().IgnoreConfig(ignoreConfig)
+	tags := flattenFrameworkTagsMap(allTags.RemoveDefaultConfig(defaultConfig))
+
+	if len(tags.Elems) == 0 && (current.IsNull() || current.IsUnknown()) {
+		tags = types.Map{ElemType: types.StringType, Null: true}
+	}
+
+	return tags, flattenFrameworkTagsMap(allTags)
+}
+
+func flattenFrameworkTagsMap(tags KeyValueTags) types.Map {
+	elems := make(map[string]attr.Value, len(tags))
+
+	for k, v := range tags.Map() {
+		elems[k] = types.String{Value: v}
+	}
+
+	return types.Map{ElemType: types.StringType, Elems: elems}
+}
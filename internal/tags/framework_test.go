@@ -0,0 +1,244 @@
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func frameworkTagsMap(m map[string]string) types.Map {
+	elems := make(map[string]attr.Value, len(m))
+
+	for k, v := range m {
+		elems[k] = types.String{Value: v}
+	}
+
+	return types.Map{ElemType: types.StringType, Elems: elems}
+}
+
+func TestPlannedTagsAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		tags          types.Map
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		want          types.Map
+		wantErr       bool
+	}{
+		{
+			name: "no tags",
+			tags: types.Map{ElemType: types.StringType, Null: true},
+			want: frameworkTagsMap(map[string]string{}),
+		},
+		{
+			name: "resource tags only",
+			tags: frameworkTagsMap(map[string]string{"key1": "value1"}),
+			want: frameworkTagsMap(map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "default tags only",
+			tags: types.Map{ElemType: types.StringType, Null: true},
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			want: frameworkTagsMap(map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "resource tags override default tags",
+			tags: frameworkTagsMap(map[string]string{"key1": "override", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1", "key3": "value3"}),
+			},
+			want: frameworkTagsMap(map[string]string{"key1": "override", "key2": "value2", "key3": "value3"}),
+		},
+		{
+			name: "ignored tags",
+			tags: frameworkTagsMap(map[string]string{"key1": "value1", "ignore:key2": "value2"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key3": "value3"}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key3"}),
+				KeyPrefixes: New([]string{"ignore:"}),
+			},
+			want: frameworkTagsMap(map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "resource tags identical to default tags",
+			tags: frameworkTagsMap(map[string]string{"key1": "value1"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key1": "value1"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown tags",
+			tags: types.Map{ElemType: types.StringType, Unknown: true},
+			want: types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		{
+			name: "unknown tag value",
+			tags: types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{"key1": types.String{Unknown: true}}},
+			want: types.Map{ElemType: types.StringType, Unknown: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := PlannedTagsAll(context.Background(), testCase.tags, testCase.defaultConfig, testCase.ignoreConfig)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("got error %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.wantErr {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenFrameworkTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		apiTags       KeyValueTags
+		current       types.Map
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		wantTags      types.Map
+		wantTagsAll   types.Map
+	}{
+		{
+			name:        "no tags, not configured",
+			apiTags:     New(map[string]string{}),
+			current:     types.Map{ElemType: types.StringType, Null: true},
+			wantTags:    types.Map{ElemType: types.StringType, Null: true},
+			wantTagsAll: frameworkTagsMap(map[string]string{}),
+		},
+		{
+			name:        "no tags, configured empty",
+			apiTags:     New(map[string]string{}),
+			current:     frameworkTagsMap(map[string]string{}),
+			wantTags:    frameworkTagsMap(map[string]string{}),
+			wantTagsAll: frameworkTagsMap(map[string]string{}),
+		},
+		{
+			name:        "AWS tags removed",
+			apiTags:     New(map[string]string{"key1": "value1", "aws:cloudformation:stack-name": "stack"}),
+			current:     frameworkTagsMap(map[string]string{"key1": "value1"}),
+			wantTags:    frameworkTagsMap(map[string]string{"key1": "value1"}),
+			wantTagsAll: frameworkTagsMap(map[string]string{"key1": "value1"}),
+		},
+		{
+			name:    "default tags removed from tags",
+			apiTags: New(map[string]string{"key1": "value1", "key2": "value2", "key3": "override"}),
+			current: frameworkTagsMap(map[string]string{"key1": "value1"}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key2": "value2", "key3": "value3"}),
+			},
+			wantTags:    frameworkTagsMap(map[string]string{"key1": "value1", "key3": "override"}),
+			wantTagsAll: frameworkTagsMap(map[string]string{"key1": "value1", "key2": "value2", "key3": "override"}),
+		},
+		{
+			name:    "only default tags",
+			apiTags: New(map[string]string{"key2": "value2"}),
+			current: types.Map{ElemType: types.StringType, Null: true},
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{"key2": "value2"}),
+			},
+			wantTags:    types.Map{ElemType: types.StringType, Null: true},
+			wantTagsAll: frameworkTagsMap(map[string]string{"key2": "value2"}),
+		},
+		{
+			name:    "ignored tags removed",
+			apiTags: New(map[string]string{"key1": "value1", "ignore:key2": "value2", "key3": "value3"}),
+			current: frameworkTagsMap(map[string]string{"key1": "value1"}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key3"}),
+				KeyPrefixes: New([]string{"ignore:"}),
+			},
+			wantTags:    frameworkTagsMap(map[string]string{"key1": "value1"}),
+			wantTagsAll: frameworkTagsMap(map[string]string{"key1": "value1"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotTags, gotTagsAll := FlattenFrameworkTags(context.Background(), testCase.apiTags, testCase.current, testCase.defaultConfig, testCase.ignoreConfig)
+
+			if diff := cmp.Diff(gotTags, testCase.wantTags); diff != "" {
+				t.Errorf("unexpected tags diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(gotTagsAll, testCase.wantTagsAll); diff != "" {
+				t.Errorf("unexpected tags_all diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlanTagsAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"tags":     TagsAttribute(),
+			"tags_all": TagsAllAttribute(),
+		},
+	}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tags": mapType, "tags_all": mapType}}
+	plan := tfsdk.Plan{
+		Schema: schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"tags":     tftypes.NewValue(mapType, map[string]tftypes.Value{"key1": tftypes.NewValue(tftypes.String, "value1")}),
+			"tags_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+		}),
+	}
+	request := resource.ModifyPlanRequest{Plan: plan}
+	response := resource.ModifyPlanResponse{Plan: plan}
+	defaultConfig := &DefaultConfig{
+		Tags: New(map[string]string{"key2": "value2"}),
+	}
+
+	ModifyPlanTagsAll(ctx, defaultConfig, nil, request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var got types.Map
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("tags_all"), &got)...)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	if diff := cmp.Diff(got, frameworkTagsMap(map[string]string{"key1": "value1", "key2": "value2"})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -19,7 +20,13 @@ type Retryable func(error) (bool, error)
 
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
 // `f` is retried until `timeout` expires.
+// If ctx carries a retry policy (see WithRetryPolicy) it determines the backoff between attempts.
+// As `f` does not take a context, the policy's AttemptTimeout has no effect; use RetryWithPolicy to bound each attempt.
 func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	if policy := RetryPolicyFromContext(ctx); policy != nil {
+		return RetryWithPolicy(ctx, timeout, policy, func(context.Context) (interface{}, error) { return f() }, retryable)
+	}
+
 	var output interface{}
 
 	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
@@ -53,6 +60,8 @@ func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (inte
 
 // RetryWhen retries the function `f` when the error it returns satisfies `predicate`.
 // `f` is retried until `timeout` expires.
+// RetryWhen and the other helpers without a context always use the default backoff; to apply a retry policy,
+// call the corresponding Context function with a context from WithRetryPolicy.
 func RetryWhen(timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
 	return RetryWhenContext(context.Background(), timeout, f, retryable)
 }
@@ -89,6 +98,22 @@ func RetryWhenAWSErrMessageContains(timeout time.Duration, f func() (interface{}
 	return RetryWhenAWSErrMessageContainsContext(context.Background(), timeout, f, code, message)
 }
 
+// RetryWhenErrorCategoryContext retries the specified function when it returns an error classified as one of the specified categories.
+func RetryWhenErrorCategoryContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), categories ...errs.Category) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if errs.IsCategory(err, categories...) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenErrorCategory retries the specified function when it returns an error classified as one of the specified categories.
+func RetryWhenErrorCategory(timeout time.Duration, f func() (interface{}, error), categories ...errs.Category) (interface{}, error) {
+	return RetryWhenErrorCategoryContext(context.Background(), timeout, f, categories...)
+}
+
 var errFoundResource = errors.New(`found resource`)
 
 // RetryUntilNotFoundContext retries the specified function until it returns a resource.NotFoundError.
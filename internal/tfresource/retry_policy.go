@@ -0,0 +1,257 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// Backoff returns the time to wait before retrying after the specified attempt (starting at 1).
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff returns a Backoff that always waits for the specified delay.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoffWithFullJitter returns a Backoff that waits for a random duration
+// between zero and base * 2^(attempt-1), capped at max.
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+func ExponentialBackoffWithFullJitter(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		ceiling := float64(base) * math.Pow(2, float64(attempt-1))
+
+		if ceiling > float64(max) || math.IsInf(ceiling, 0) {
+			ceiling = float64(max)
+		}
+
+		if ceiling <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(ceiling) + 1))
+	}
+}
+
+// RetryPolicy controls how RetryWithPolicy retries a function.
+type RetryPolicy struct {
+	// Backoff determines the delay between attempts.
+	// Defaults to exponential backoff with full jitter between 0 and 30s, starting at 1s.
+	Backoff Backoff
+
+	// MaxAttempts is the maximum number of attempts. Zero means unlimited.
+	MaxAttempts int
+
+	// AttemptTimeout is the deadline of the context passed to each attempt. Zero means no deadline.
+	// It only applies to functions that take that context, see RetryWithPolicy.
+	AttemptTimeout time.Duration
+
+	// Throttle, if set, is waited on before each attempt and is slowed down when an attempt is throttled.
+	// Share a single Throttle between all callers of a throttled API.
+	Throttle *Throttle
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is specified.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Backoff: ExponentialBackoffWithFullJitter(1*time.Second, 30*time.Second),
+	}
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a copy of ctx that carries the specified retry policy.
+// RetryWhenContext and the functions built on it use this policy instead of the default resource.StateChangeConf backoff.
+// The helpers that do not take a context, such as RetryWhen, never apply a retry policy; use their Context variants instead.
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy carried by ctx, or nil.
+func RetryPolicyFromContext(ctx context.Context) *RetryPolicy {
+	policy, _ := ctx.Value(retryPolicyKey{}).(*RetryPolicy)
+
+	return policy
+}
+
+// ErrMaxAttemptsExceeded is matched by the error returned when a retry policy's attempts are exhausted.
+// That error also wraps the last attempt's error.
+var ErrMaxAttemptsExceeded = errors.New("maximum attempts exceeded")
+
+type maxAttemptsExceededError struct {
+	maxAttempts int
+	lastErr     error
+}
+
+func (e *maxAttemptsExceededError) Error() string {
+	return fmt.Sprintf("%s (%d): %s", ErrMaxAttemptsExceeded, e.maxAttempts, e.lastErr)
+}
+
+func (e *maxAttemptsExceededError) Is(target error) bool {
+	return target == ErrMaxAttemptsExceeded
+}
+
+func (e *maxAttemptsExceededError) Unwrap() error {
+	return e.lastErr
+}
+
+// RetryWithPolicy retries the function `f` when the error it returns satisfies `retryable`,
+// waiting between attempts as determined by `policy`.
+// `f` is retried until `timeout` expires or the policy's attempts are exhausted.
+// As with RetryWhenContext, `f` is attempted one final time once `timeout` expires.
+// Each attempt and the reason for each retry are logged.
+func RetryWithPolicy(ctx context.Context, timeout time.Duration, policy *RetryPolicy, f func(context.Context) (interface{}, error), retryable Retryable) (interface{}, error) {
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	backoff := policy.Backoff
+	if backoff == nil {
+		backoff = DefaultRetryPolicy().Backoff
+	}
+
+	parentCtx := ctx
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		if policy.Throttle != nil {
+			if err := policy.Throttle.Wait(ctx); err != nil {
+				return finalAttempt(parentCtx, timeout, policy, f, retryable, nil)
+			}
+		}
+
+		output, err := attemptWithTimeout(ctx, policy.AttemptTimeout, f)
+		retry, err := retryable(err)
+
+		fields := map[string]interface{}{
+			"attempt": attempt,
+			"elapsed": time.Since(start).String(),
+		}
+
+		if !retry {
+			if policy.Throttle != nil && err == nil {
+				policy.Throttle.Success()
+			}
+
+			if err != nil {
+				fields["error"] = err.Error()
+			}
+			tflog.Debug(ctx, "Retryable operation finished", fields)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return output, nil
+		}
+
+		throttled := isThrottlingError(err)
+		if throttled && policy.Throttle != nil {
+			policy.Throttle.Throttled()
+		}
+
+		fields["reason"] = retryReason(err, throttled)
+
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			tflog.Debug(ctx, "Retryable operation exceeded maximum attempts", fields)
+
+			return nil, &maxAttemptsExceededError{
+				maxAttempts: policy.MaxAttempts,
+				lastErr:     err,
+			}
+		}
+
+		delay := backoff(attempt)
+		fields["delay"] = delay.String()
+		tflog.Debug(ctx, "Retrying operation", fields)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return finalAttempt(parentCtx, timeout, policy, f, retryable, err)
+		case <-timer.C:
+		}
+	}
+}
+
+// finalAttempt attempts `f` once more after the retry timeout has expired, unless ctx is done.
+// Like every other attempt, the final attempt first waits on the policy's throttle, if any.
+// Returns a timeout error wrapping the last error if the attempt is not made or is retryable.
+func finalAttempt(ctx context.Context, timeout time.Duration, policy *RetryPolicy, f func(context.Context) (interface{}, error), retryable Retryable, lastErr error) (interface{}, error) {
+	if policy.Throttle != nil && ctx.Err() == nil {
+		if err := policy.Throttle.Wait(ctx); err != nil {
+			return nil, &resource.TimeoutError{
+				LastError: lastErr,
+				Timeout:   timeout,
+			}
+		}
+	}
+
+	if ctx.Err() == nil {
+		output, err := attemptWithTimeout(ctx, policy.AttemptTimeout, f)
+		retry, err := retryable(err)
+
+		if !retry {
+			if err != nil {
+				return nil, err
+			}
+
+			if policy.Throttle != nil {
+				policy.Throttle.Success()
+			}
+
+			return output, nil
+		}
+
+		if policy.Throttle != nil && isThrottlingError(err) {
+			policy.Throttle.Throttled()
+		}
+
+		lastErr = err
+	}
+
+	return nil, &resource.TimeoutError{
+		LastError: lastErr,
+		Timeout:   timeout,
+	}
+}
+
+func attemptWithTimeout(ctx context.Context, timeout time.Duration, f func(context.Context) (interface{}, error)) (interface{}, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return f(ctx)
+}
+
+func retryReason(err error, throttled bool) string {
+	switch {
+	case throttled:
+		return "throttled"
+	case err == nil:
+		return "unspecified"
+	default:
+		return err.Error()
+	}
+}
+
+// isThrottlingError returns whether the error indicates that the request was throttled.
+func isThrottlingError(err error) bool {
+	return errs.IsCategory(err, errs.CategoryThrottling)
+}
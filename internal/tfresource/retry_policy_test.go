@@ -0,0 +1,302 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExponentialBackoffWithFullJitter(t *testing.T) {
+	t.Parallel()
+
+	backoff := tfresource.ExponentialBackoffWithFullJitter(100*time.Millisecond, 1*time.Second)
+
+	for attempt := 1; attempt <= 100; attempt++ {
+		ceiling := 100 * time.Millisecond << (attempt - 1)
+		if ceiling > 1*time.Second || ceiling <= 0 {
+			ceiling = 1 * time.Second
+		}
+
+		if got := backoff(attempt); got < 0 || got > ceiling {
+			t.Errorf("attempt %d: got delay %s, expected between 0 and %s", attempt, got, ceiling)
+		}
+	}
+}
+
+func TestRetryWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	errRetryable := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	errOther := errors.New("other")
+	retryable := func(err error) (bool, error) {
+		if errors.Is(err, errRetryable) {
+			return true, err
+		}
+
+		return false, err
+	}
+
+	testCases := []struct {
+		Name             string
+		Policy           *tfresource.RetryPolicy
+		Errors           []error // Returned by successive attempts, then nil
+		ExpectedAttempts int
+		ExpectError      bool
+		ExpectMaxErr     bool
+		ExpectTimeoutErr bool
+	}{
+		{
+			Name:             "success",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(0)},
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "retry then success",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(0)},
+			Errors:           []error{errRetryable, errRetryable},
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "non-retryable",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(0)},
+			Errors:           []error{errRetryable, errOther},
+			ExpectedAttempts: 2,
+			ExpectError:      true,
+		},
+		{
+			Name:             "max attempts",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(0), MaxAttempts: 2},
+			Errors:           []error{errRetryable, errRetryable, errRetryable},
+			ExpectedAttempts: 2,
+			ExpectError:      true,
+			ExpectMaxErr:     true,
+		},
+		{
+			Name:             "timeout",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(1 * time.Minute)},
+			Errors:           []error{errRetryable, errRetryable},
+			ExpectedAttempts: 2,
+			ExpectError:      true,
+			ExpectTimeoutErr: true,
+		},
+		{
+			Name:             "timeout then final attempt success",
+			Policy:           &tfresource.RetryPolicy{Backoff: tfresource.ConstantBackoff(1 * time.Minute)},
+			Errors:           []error{errRetryable},
+			ExpectedAttempts: 2,
+		},
+		{
+			Name: "throttle",
+			Policy: &tfresource.RetryPolicy{
+				Backoff:  tfresource.ConstantBackoff(0),
+				Throttle: tfresource.NewThrottle(1000, 1),
+			},
+			Errors:           []error{errRetryable},
+			ExpectedAttempts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			f := func(context.Context) (interface{}, error) {
+				attempts++
+
+				if attempts <= len(testCase.Errors) {
+					return nil, testCase.Errors[attempts-1]
+				}
+
+				return attempts, nil
+			}
+
+			_, err := tfresource.RetryWithPolicy(context.Background(), 100*time.Millisecond, testCase.Policy, f, retryable)
+
+			if got, expected := attempts, testCase.ExpectedAttempts; got != expected {
+				t.Errorf("got %d attempts, expected %d", got, expected)
+			}
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectMaxErr {
+				if !errors.Is(err, tfresource.ErrMaxAttemptsExceeded) {
+					t.Errorf("expected maximum attempts error, got: %s", err)
+				}
+
+				if !errors.Is(err, errRetryable) {
+					t.Errorf("expected last error, got: %s", err)
+				}
+			}
+
+			if testCase.ExpectTimeoutErr {
+				if tfresource.TimedOut(err) {
+					t.Errorf("expected timeout error with last error, got: %s", err)
+				}
+
+				if !errors.Is(err, errRetryable) {
+					t.Errorf("expected last error, got: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryWithPolicyFinalAttemptThrottled(t *testing.T) {
+	t.Parallel()
+
+	// A token every 500ms, so that the final attempt must wait for one.
+	throttle := tfresource.NewThrottle(2, 1)
+	policy := &tfresource.RetryPolicy{
+		Backoff:  tfresource.ConstantBackoff(1 * time.Minute),
+		Throttle: throttle,
+	}
+
+	attempts := 0
+	start := time.Now()
+
+	_, err := tfresource.RetryWithPolicy(context.Background(), 100*time.Millisecond, policy, func(context.Context) (interface{}, error) {
+		attempts++
+
+		return nil, awserr.New("ThrottlingException", "Rate exceeded", nil)
+	}, func(err error) (bool, error) {
+		return true, err
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, expected := attempts, 2; got != expected {
+		t.Errorf("got %d attempts, expected %d", got, expected)
+	}
+
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("final attempt made after %s, expected it to wait for a throttle token", elapsed)
+	}
+
+	// Both attempts were throttled.
+	if got, expected := throttle.Rate(), 0.5; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+}
+
+func TestRetryWhenContextWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	ctx := tfresource.WithRetryPolicy(context.Background(), &tfresource.RetryPolicy{
+		Backoff:     tfresource.ConstantBackoff(0),
+		MaxAttempts: 3,
+	})
+
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, 1*time.Minute, func() (interface{}, error) {
+		attempts++
+
+		return nil, awserr.New("TestCode", "TestMessage", nil)
+	}, "TestCode")
+
+	if !errors.Is(err, tfresource.ErrMaxAttemptsExceeded) {
+		t.Errorf("expected maximum attempts error, got: %s", err)
+	}
+
+	if got, expected := attempts, 3; got != expected {
+		t.Errorf("got %d attempts, expected %d", got, expected)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	t.Parallel()
+
+	throttle := tfresource.NewThrottle(1000, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if err := throttle.Wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	throttle.Throttled()
+	if got, expected := throttle.Rate(), 500.0; got != expected {
+		t.Errorf("got rate %f after throttling, expected %f", got, expected)
+	}
+
+	for i := 0; i < 10; i++ {
+		throttle.Throttled()
+	}
+	if got, expected := throttle.Rate(), 1000.0/16; got != expected {
+		t.Errorf("got minimum rate %f, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		throttle.Success()
+	}
+	if got, expected := throttle.Rate(), 1000.0; got != expected {
+		t.Errorf("got rate %f after recovery, expected %f", got, expected)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	slow := tfresource.NewThrottle(0.001, 1)
+
+	if err := slow.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := slow.Wait(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewThrottleInvalidRate(t *testing.T) {
+	t.Parallel()
+
+	for _, rate := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("rate %v: expected panic", rate)
+				}
+			}()
+
+			tfresource.NewThrottle(rate, 1)
+		}()
+	}
+}
+
+func TestSharedThrottle(t *testing.T) {
+	t.Parallel()
+
+	scope1, scope2 := new(int), new(int)
+
+	a := tfresource.SharedThrottle(scope1, "TestSharedThrottle", 10, 10)
+	b := tfresource.SharedThrottle(scope1, "TestSharedThrottle", 20, 20)
+
+	if a != b {
+		t.Error("expected the same throttle")
+	}
+
+	if got, expected := b.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	if c := tfresource.SharedThrottle(scope2, "TestSharedThrottle", 20, 20); c == a {
+		t.Error("expected a different throttle for a different scope")
+	}
+
+	if c := tfresource.SharedThrottle(scope1, "TestSharedThrottle_other", 20, 20); c == a {
+		t.Error("expected a different throttle for a different key")
+	}
+}
@@ -0,0 +1,153 @@
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Throttle is an adaptive token bucket that limits the rate at which callers make requests.
+// The refill rate is halved each time a request is throttled, down to a minimum,
+// and recovers additively after each successful request.
+// A Throttle is safe for concurrent use and is intended to be shared by all callers of a service.
+type Throttle struct {
+	mu      sync.Mutex
+	burst   float64
+	last    time.Time
+	maxRate float64 // Tokens per second
+	minRate float64
+	rate    float64
+	tokens  float64
+}
+
+// NewThrottle returns a throttle allowing rate requests per second on average, with bursts of up to burst requests.
+// Panics if rate is not positive.
+func NewThrottle(rate float64, burst int) *Throttle {
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		panic(fmt.Sprintf("tfresource: invalid throttle rate: %v", rate))
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Throttle{
+		burst:   float64(burst),
+		maxRate: rate,
+		minRate: rate / 16,
+		rate:    rate,
+		tokens:  float64(burst),
+	}
+}
+
+// Wait blocks until a request may be made or ctx is done.
+func (t *Throttle) Wait(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		t.refill(time.Now())
+
+		if t.tokens >= 1 {
+			t.tokens--
+			t.mu.Unlock()
+
+			return nil
+		}
+
+		wait := time.Duration((1 - t.tokens) / t.rate * float64(time.Second))
+		t.mu.Unlock()
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Throttled records that a request was throttled, slowing down all callers.
+func (t *Throttle) Throttled() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.refill(time.Now())
+
+	t.rate /= 2
+	if t.rate < t.minRate {
+		t.rate = t.minRate
+	}
+}
+
+// Success records that a request succeeded, gradually restoring the original rate.
+func (t *Throttle) Success() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.refill(time.Now())
+
+	t.rate += t.maxRate / 16
+	if t.rate > t.maxRate {
+		t.rate = t.maxRate
+	}
+}
+
+// Rate returns the current refill rate in requests per second.
+func (t *Throttle) Rate() float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.rate
+}
+
+func (t *Throttle) refill(now time.Time) {
+	if !t.last.IsZero() {
+		t.tokens += now.Sub(t.last).Seconds() * t.rate
+
+		if t.tokens > t.burst {
+			t.tokens = t.burst
+		}
+	}
+
+	t.last = now
+}
+
+type throttleKey struct {
+	scope interface{}
+	key   string
+}
+
+var (
+	throttles   = make(map[throttleKey]*Throttle)
+	throttlesMu sync.Mutex
+)
+
+// SharedThrottle returns the throttle registered for the specified scope and key, creating it with the specified rate and burst
+// if it does not exist. The scope is typically the AWS client's service connection, so that provider configurations for
+// different accounts or Regions each have their own throttle, and the key is the service name.
+// The scope must be comparable, e.g. a pointer.
+func SharedThrottle(scope interface{}, key string, rate float64, burst int) *Throttle {
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+
+	k := throttleKey{scope: scope, key: key}
+
+	if t, ok := throttles[k]; ok {
+		return t
+	}
+
+	t := NewThrottle(rate, burst)
+	throttles[k] = t
+
+	return t
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
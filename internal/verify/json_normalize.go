@@ -0,0 +1,591 @@
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// JSONNormalizer normalizes JSON documents so that semantically equivalent documents compare equal.
+// Which normalizations apply, and where in a document, is configured per argument.
+//
+// Paths identify locations within a document as dot-separated segments.
+// A segment matches an object key or, for arrays, an element index.
+// The `*` segment matches any single key or index and the `**` segment matches any number of keys or indices.
+// For example `States.*.Retry.*.MaxAttempts` or `**.ErrorEquals`.
+type JSONNormalizer struct {
+	accountIDPrincipals []jsonPath
+	defaults            []jsonDefault
+	omitNulls           bool
+	orderedArrays       []jsonPath
+	scalarArrays        []jsonPath
+	siblingDefaults     []jsonSiblingDefault
+	unorderedArrays     []jsonPath
+}
+
+type jsonPath []string
+
+type jsonDefault struct {
+	path  jsonPath
+	value interface{}
+}
+
+type jsonSiblingDefault struct {
+	path    jsonPath
+	sibling string
+}
+
+// JSONNormalizerOptionsFunc configures a JSONNormalizer.
+type JSONNormalizerOptionsFunc func(*JSONNormalizer)
+
+// WithUnorderedArrays treats arrays at the specified paths as ordering-insensitive.
+func WithUnorderedArrays(paths ...string) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.unorderedArrays = append(n.unorderedArrays, parseJSONPaths(paths)...)
+	}
+}
+
+// WithOrderedArrays keeps the ordering of arrays at the specified paths significant,
+// even where they also match a path specified with WithUnorderedArrays.
+func WithOrderedArrays(paths ...string) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.orderedArrays = append(n.orderedArrays, parseJSONPaths(paths)...)
+	}
+}
+
+// WithDefaultValue treats an object key at the specified path whose value is the specified default as absent.
+func WithDefaultValue(path string, value interface{}) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.defaults = append(n.defaults, jsonDefault{
+			path:  parseJSONPath(path),
+			value: normalizeJSONLiteral(value),
+		})
+	}
+}
+
+// WithSiblingDefaultValue treats an object key at the specified path whose value is that of the sibling key as absent,
+// e.g. a port mapping's host port that defaults to its container port.
+func WithSiblingDefaultValue(path, sibling string) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.siblingDefaults = append(n.siblingDefaults, jsonSiblingDefault{
+			path:    parseJSONPath(path),
+			sibling: sibling,
+		})
+	}
+}
+
+// WithScalarArrays treats single-element arrays at the specified paths as equivalent to their only element.
+func WithScalarArrays(paths ...string) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.scalarArrays = append(n.scalarArrays, parseJSONPaths(paths)...)
+	}
+}
+
+// WithAccountIDPrincipals treats account root principal ARNs, e.g. `arn:aws:iam::123456789012:root`,
+// at the specified paths as equivalent to the account ID, e.g. `123456789012`.
+func WithAccountIDPrincipals(paths ...string) JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.accountIDPrincipals = append(n.accountIDPrincipals, parseJSONPaths(paths)...)
+	}
+}
+
+// WithOmitNulls treats object keys with a null value as absent.
+func WithOmitNulls() JSONNormalizerOptionsFunc {
+	return func(n *JSONNormalizer) {
+		n.omitNulls = true
+	}
+}
+
+// NewJSONNormalizer returns a JSONNormalizer with the specified normalizations.
+// With no normalizations, documents are equivalent if they are equal after unmarshaling.
+func NewJSONNormalizer(optFns ...JSONNormalizerOptionsFunc) *JSONNormalizer {
+	n := &JSONNormalizer{}
+
+	for _, fn := range optFns {
+		fn(n)
+	}
+
+	return n
+}
+
+var (
+	// StepFunctionsDefinitionNormalizer normalizes AWS Step Functions state machine definitions (Amazon States Language).
+	StepFunctionsDefinitionNormalizer = NewJSONNormalizer(
+		WithUnorderedArrays("**.ErrorEquals"),
+		WithDefaultValue("**.Retry.*.IntervalSeconds", 1),
+		WithDefaultValue("**.Retry.*.MaxAttempts", 3),
+		WithDefaultValue("**.Retry.*.BackoffRate", 2),
+		WithDefaultValue("**.States.*.End", false),
+	)
+
+	// ContainerDefinitionsNormalizer normalizes Amazon ECS task definition container definitions
+	// for tasks that don't use the awsvpc network mode.
+	ContainerDefinitionsNormalizer = NewJSONNormalizer(containerDefinitionsNormalizerOptions...)
+
+	// AWSVPCContainerDefinitionsNormalizer normalizes Amazon ECS task definition container definitions
+	// for tasks that use the awsvpc network mode, in which a port mapping's host port defaults to its container port.
+	AWSVPCContainerDefinitionsNormalizer = NewJSONNormalizer(append(
+		containerDefinitionsNormalizerOptions,
+		WithSiblingDefaultValue("*.portMappings.*.hostPort", "containerPort"),
+	)...)
+
+	// EventPatternNormalizer normalizes Amazon EventBridge event patterns.
+	// Arrays of values to match, and $or conditions, are sets so ordering is insignificant.
+	// The operands of content filters are not, e.g. `{"numeric":[">",0,"<=",5]}`.
+	EventPatternNormalizer = NewJSONNormalizer(
+		WithUnorderedArrays("**"),
+		WithOrderedArrays(
+			"**.anything-but",
+			"**.cidr",
+			"**.equals-ignore-case",
+			"**.exists",
+			"**.numeric",
+			"**.prefix",
+			"**.suffix",
+			"**.wildcard",
+		),
+	)
+
+	// DashboardBodyNormalizer normalizes Amazon CloudWatch dashboard bodies.
+	DashboardBodyNormalizer = NewJSONNormalizer(
+		WithDefaultValue("widgets.*.properties.stacked", false),
+		WithDefaultValue("widgets.*.properties.view", "timeSeries"),
+	)
+
+	// PolicyDocumentNormalizer normalizes IAM-style policy documents for ChangeWarnings.
+	// Whether policy documents are equivalent is still decided by awspolicyequivalence, see SuppressEquivalentPolicyDiffs.
+	PolicyDocumentNormalizer = NewJSONNormalizer(
+		WithScalarArrays(policyDocumentSetPaths...),
+		WithUnorderedArrays(policyDocumentSetPaths...),
+		WithAccountIDPrincipals(policyStatementPaths(
+			"NotPrincipal.AWS",
+			"NotPrincipal.AWS.*",
+			"Principal.AWS",
+			"Principal.AWS.*",
+		)...),
+	)
+)
+
+var containerDefinitionsNormalizerOptions = []JSONNormalizerOptionsFunc{
+	WithOmitNulls(),
+	WithUnorderedArrays("*.environment"),
+	WithDefaultValue("*.cpu", 0),
+	WithDefaultValue("*.essential", true),
+	WithDefaultValue("*.portMappings.*.hostPort", 0),
+	WithDefaultValue("*.portMappings.*.protocol", "tcp"),
+	WithDefaultValue("*.*", []interface{}{}),
+}
+
+// policyDocumentSetPaths are the paths of policy document elements whose values are sets,
+// written either as an array or, for a single value, as a scalar.
+var policyDocumentSetPaths = append([]string{"Statement"}, policyStatementPaths(
+	"Action",
+	"NotAction",
+	"NotPrincipal.*",
+	"NotResource",
+	"Principal.*",
+	"Resource",
+)...)
+
+// policyStatementPaths returns the paths of the specified statement elements
+// both for a policy document whose Statement is an array and for one whose Statement is a single object.
+func policyStatementPaths(elements ...string) []string {
+	paths := make([]string, 0, 2*len(elements))
+
+	for _, element := range elements {
+		paths = append(paths, "Statement.*."+element, "Statement."+element)
+	}
+
+	return paths
+}
+
+// Normalize returns the normalized, compact form of the specified JSON document.
+func (n *JSONNormalizer) Normalize(s string) (string, error) {
+	v, err := n.normalizeString(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// Equivalent returns whether the specified JSON documents are equivalent once normalized.
+func (n *JSONNormalizer) Equivalent(s1, s2 string) (bool, error) {
+	v1, err := n.normalizeString(s1)
+
+	if err != nil {
+		return false, err
+	}
+
+	v2, err := n.normalizeString(s2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(v1, v2), nil
+}
+
+// Diff returns a human-readable description of each semantic difference between the specified JSON documents,
+// one per line and sorted by path. No differences are returned for equivalent documents.
+func (n *JSONNormalizer) Diff(old, new string) ([]string, error) {
+	v1, err := n.normalizeString(old)
+
+	if err != nil {
+		return nil, fmt.Errorf("normalizing old document: %w", err)
+	}
+
+	v2, err := n.normalizeString(new)
+
+	if err != nil {
+		return nil, fmt.Errorf("normalizing new document: %w", err)
+	}
+
+	var diffs []string
+	jsonDiff("", v1, v2, &diffs)
+
+	return diffs, nil
+}
+
+// SuppressDiffs is a schema.SchemaDiffSuppressFunc that suppresses differences between equivalent JSON documents.
+func (n *JSONNormalizer) SuppressDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if strings.TrimSpace(old) == "" && strings.TrimSpace(new) == "" {
+		return true
+	}
+
+	if strings.TrimSpace(old) == "" {
+		return false
+	}
+
+	equivalent, err := n.Equivalent(old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// ChangeWarnings returns a warning diagnostic listing the semantic differences, see Diff, between the prior
+// and new values of the specified JSON document argument. Resources return it from their update handler,
+// so the warning is shown when the change is applied, not when it is planned: the Plugin SDK only allows
+// CustomizeDiff to fail a plan, not to add warnings to it. Arguments that force a new resource, such as
+// aws_ecs_task_definition's container_definitions, are never updated and so only use SuppressDiffs.
+// No diagnostics are returned if the argument is unchanged, was previously empty or isn't valid JSON.
+func (n *JSONNormalizer) ChangeWarnings(d *schema.ResourceData, k string) diag.Diagnostics {
+	if !d.HasChange(k) {
+		return nil
+	}
+
+	o, nw := d.GetChange(k)
+	old, new := o.(string), nw.(string)
+
+	if strings.TrimSpace(old) == "" {
+		return nil
+	}
+
+	diffs, err := n.Diff(old, new)
+
+	if err != nil || len(diffs) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("%s changed", k),
+			Detail:        strings.Join(diffs, "\n"),
+			AttributePath: cty.GetAttrPath(k),
+		},
+	}
+}
+
+// SecondUnlessEquivalent returns the old document if the documents are equivalent.
+// Otherwise, it returns the new document.
+func (n *JSONNormalizer) SecondUnlessEquivalent(old, new string) (string, error) {
+	if strings.TrimSpace(old) == "" {
+		return new, nil
+	}
+
+	equivalent, err := n.Equivalent(old, new)
+
+	if err != nil {
+		return "", err
+	}
+
+	if equivalent {
+		return old, nil
+	}
+
+	return new, nil
+}
+
+func (n *JSONNormalizer) normalizeString(s string) (interface{}, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return n.normalize(nil, v), nil
+}
+
+// normalize returns the normalized form of the value at the specified path.
+// Values are normalized bottom-up so that, for example, elements are normalized before an array is sorted.
+func (n *JSONNormalizer) normalize(path []string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+
+		for key, value := range v {
+			childPath := appendJSONPath(path, key)
+			value = n.normalize(childPath, value)
+
+			if value == nil && n.omitNulls {
+				continue
+			}
+
+			if n.isDefault(childPath, value) || n.isSiblingDefault(childPath, value, v) {
+				continue
+			}
+
+			result[key] = value
+		}
+
+		return result
+
+	case []interface{}:
+		result := make([]interface{}, len(v))
+
+		for i, value := range v {
+			result[i] = n.normalize(appendJSONPath(path, strconv.Itoa(i)), value)
+		}
+
+		if len(result) == 1 && matchAnyJSONPath(n.scalarArrays, path) {
+			return result[0]
+		}
+
+		if matchAnyJSONPath(n.unorderedArrays, path) && !matchAnyJSONPath(n.orderedArrays, path) {
+			keys := make(map[int]string, len(result))
+			for i, value := range result {
+				b, _ := json.Marshal(value)
+				keys[i] = string(b)
+			}
+
+			indices := make([]int, len(result))
+			for i := range indices {
+				indices[i] = i
+			}
+			sort.SliceStable(indices, func(i, j int) bool {
+				return keys[indices[i]] < keys[indices[j]]
+			})
+
+			sorted := make([]interface{}, len(result))
+			for i, index := range indices {
+				sorted[i] = result[index]
+			}
+			result = sorted
+		}
+
+		return result
+
+	case string:
+		if matchAnyJSONPath(n.accountIDPrincipals, path) {
+			if m := accountRootPrincipalRegexp.FindStringSubmatch(v); m != nil {
+				return m[1]
+			}
+		}
+
+		return v
+
+	default:
+		return v
+	}
+}
+
+var accountRootPrincipalRegexp = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+func (n *JSONNormalizer) isDefault(path []string, v interface{}) bool {
+	for _, d := range n.defaults {
+		if d.path.match(path) && reflect.DeepEqual(d.value, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSiblingDefault returns whether the value at the specified path is that of its sibling key in the parent object.
+func (n *JSONNormalizer) isSiblingDefault(path []string, v interface{}, parent map[string]interface{}) bool {
+	for _, d := range n.siblingDefaults {
+		if sibling, ok := parent[d.sibling]; ok && d.path.match(path) && reflect.DeepEqual(sibling, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonDiff appends a description of each difference between the values at the specified path.
+func jsonDiff(path string, v1, v2 interface{}, diffs *[]string) {
+	switch o1 := v1.(type) {
+	case map[string]interface{}:
+		if o2, ok := v2.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(o1)+len(o2))
+			for key := range o1 {
+				keys = append(keys, key)
+			}
+			for key := range o2 {
+				if _, ok := o1[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				childPath := key
+				if path != "" {
+					childPath = path + "." + key
+				}
+
+				e1, ok1 := o1[key]
+				e2, ok2 := o2[key]
+
+				switch {
+				case !ok1:
+					*diffs = append(*diffs, fmt.Sprintf("%s: added %s", childPath, jsonLiteral(e2)))
+				case !ok2:
+					*diffs = append(*diffs, fmt.Sprintf("%s: removed %s", childPath, jsonLiteral(e1)))
+				default:
+					jsonDiff(childPath, e1, e2, diffs)
+				}
+			}
+
+			return
+		}
+
+	case []interface{}:
+		if a2, ok := v2.([]interface{}); ok {
+			for i := 0; i < len(o1) || i < len(a2); i++ {
+				childPath := fmt.Sprintf("%s[%d]", path, i)
+
+				switch {
+				case i >= len(o1):
+					*diffs = append(*diffs, fmt.Sprintf("%s: added %s", childPath, jsonLiteral(a2[i])))
+				case i >= len(a2):
+					*diffs = append(*diffs, fmt.Sprintf("%s: removed %s", childPath, jsonLiteral(o1[i])))
+				default:
+					jsonDiff(childPath, o1[i], a2[i], diffs)
+				}
+			}
+
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(v1, v2) {
+		if path == "" {
+			path = "(root)"
+		}
+
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s => %s", path, jsonLiteral(v1), jsonLiteral(v2)))
+	}
+}
+
+func jsonLiteral(v interface{}) string {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+// normalizeJSONLiteral returns the value as it would be unmarshaled from JSON, e.g. 1 => float64(1).
+func normalizeJSONLiteral(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return v
+	}
+
+	var result interface{}
+
+	if err := json.Unmarshal(b, &result); err != nil {
+		return v
+	}
+
+	return result
+}
+
+func parseJSONPath(s string) jsonPath {
+	if s == "" {
+		return jsonPath{}
+	}
+
+	return strings.Split(s, ".")
+}
+
+func parseJSONPaths(ss []string) []jsonPath {
+	paths := make([]jsonPath, len(ss))
+
+	for i, s := range ss {
+		paths[i] = parseJSONPath(s)
+	}
+
+	return paths
+}
+
+func appendJSONPath(path []string, segment string) []string {
+	return append(path[:len(path):len(path)], segment)
+}
+
+func matchAnyJSONPath(patterns []jsonPath, path []string) bool {
+	for _, pattern := range patterns {
+		if pattern.match(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// match returns whether the path pattern matches the specified path.
+func (p jsonPath) match(path []string) bool {
+	if len(p) == 0 {
+		return len(path) == 0
+	}
+
+	switch p[0] {
+	case "**":
+		for i := 0; i <= len(path); i++ {
+			if p[1:].match(path[i:]) {
+				return true
+			}
+		}
+
+		return false
+
+	case "*":
+		return len(path) > 0 && p[1:].match(path[1:])
+
+	default:
+		return len(path) > 0 && p[0] == path[0] && p[1:].match(path[1:])
+	}
+}
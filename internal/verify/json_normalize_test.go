@@ -0,0 +1,343 @@
+package verify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestJSONNormalizerEquivalent(t *testing.T) {
+	testCases := []struct {
+		name       string
+		normalizer *JSONNormalizer
+		s1         string
+		s2         string
+		want       bool
+	}{
+		{
+			name:       "no normalizations whitespace",
+			normalizer: NewJSONNormalizer(),
+			s1:         `{"a":[1,2]}`,
+			s2:         `{ "a" : [ 1, 2 ] }`,
+			want:       true,
+		},
+		{
+			name:       "no normalizations ordered array",
+			normalizer: NewJSONNormalizer(),
+			s1:         `{"a":[1,2]}`,
+			s2:         `{"a":[2,1]}`,
+			want:       false,
+		},
+		{
+			name:       "unordered array",
+			normalizer: NewJSONNormalizer(WithUnorderedArrays("a")),
+			s1:         `{"a":[{"x":1},{"x":2}],"b":[1,2]}`,
+			s2:         `{"a":[{"x":2},{"x":1}],"b":[1,2]}`,
+			want:       true,
+		},
+		{
+			name:       "unordered array other path",
+			normalizer: NewJSONNormalizer(WithUnorderedArrays("a")),
+			s1:         `{"b":[1,2]}`,
+			s2:         `{"b":[2,1]}`,
+			want:       false,
+		},
+		{
+			name:       "unordered array recursive wildcard",
+			normalizer: NewJSONNormalizer(WithUnorderedArrays("**.c")),
+			s1:         `{"a":{"b":[{"c":[1,2]}]},"c":["x","y"]}`,
+			s2:         `{"a":{"b":[{"c":[2,1]}]},"c":["y","x"]}`,
+			want:       true,
+		},
+		{
+			name:       "default value",
+			normalizer: NewJSONNormalizer(WithDefaultValue("*.n", 3)),
+			s1:         `[{"n":3,"m":1}]`,
+			s2:         `[{"m":1}]`,
+			want:       true,
+		},
+		{
+			name:       "non-default value",
+			normalizer: NewJSONNormalizer(WithDefaultValue("*.n", 3)),
+			s1:         `[{"n":4,"m":1}]`,
+			s2:         `[{"m":1}]`,
+			want:       false,
+		},
+		{
+			name:       "scalar array",
+			normalizer: NewJSONNormalizer(WithScalarArrays("Statement.*.Action")),
+			s1:         `{"Statement":[{"Action":["s3:GetObject"]}]}`,
+			s2:         `{"Statement":[{"Action":"s3:GetObject"}]}`,
+			want:       true,
+		},
+		{
+			name:       "scalar array multiple elements",
+			normalizer: NewJSONNormalizer(WithScalarArrays("Statement.*.Action")),
+			s1:         `{"Statement":[{"Action":["s3:GetObject","s3:PutObject"]}]}`,
+			s2:         `{"Statement":[{"Action":"s3:GetObject"}]}`,
+			want:       false,
+		},
+		{
+			name:       "ordered array overrides unordered",
+			normalizer: NewJSONNormalizer(WithUnorderedArrays("**"), WithOrderedArrays("**.b")),
+			s1:         `{"a":[1,2],"c":{"b":[1,2]}}`,
+			s2:         `{"a":[2,1],"c":{"b":[2,1]}}`,
+			want:       false,
+		},
+		{
+			name:       "omit nulls",
+			normalizer: NewJSONNormalizer(WithOmitNulls()),
+			s1:         `{"a":null,"b":1}`,
+			s2:         `{"b":1}`,
+			want:       true,
+		},
+		{
+			name:       "Step Functions definition",
+			normalizer: StepFunctionsDefinitionNormalizer,
+			s1: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:test",
+      "Retry": [{"ErrorEquals": ["States.Timeout", "States.TaskFailed"], "MaxAttempts": 3, "IntervalSeconds": 1}],
+      "End": true
+    }
+  }
+}`,
+			s2:   `{"StartAt":"A","States":{"A":{"Type":"Task","Resource":"arn:aws:lambda:us-west-2:123456789012:function:test","Retry":[{"ErrorEquals":["States.TaskFailed","States.Timeout"]}],"End":true}}}`,
+			want: true,
+		},
+		{
+			name: "account ID principal",
+			normalizer: NewJSONNormalizer(
+				WithAccountIDPrincipals("Statement.*.Principal.AWS", "Statement.*.Principal.AWS.*"),
+				WithScalarArrays("Statement.*.Principal.AWS"),
+			),
+			s1:   `{"Statement":[{"Principal":{"AWS":["arn:aws-us-gov:iam::123456789012:root"]}}]}`,
+			s2:   `{"Statement":[{"Principal":{"AWS":"123456789012"}}]}`,
+			want: true,
+		},
+		{
+			name:       "account ID principal role",
+			normalizer: NewJSONNormalizer(WithAccountIDPrincipals("Principal.AWS")),
+			s1:         `{"Principal":{"AWS":"arn:aws:iam::123456789012:role/test"}}`,
+			s2:         `{"Principal":{"AWS":"123456789012"}}`,
+			want:       false,
+		},
+		{
+			name:       "omit nulls",
+			normalizer: NewJSONNormalizer(WithOmitNulls()),
+			s1:         `{"a":1,"b":null}`,
+			s2:         `{"a":1}`,
+			want:       true,
+		},
+		{
+			name:       "sibling default",
+			normalizer: NewJSONNormalizer(WithSiblingDefaultValue("*.b", "a")),
+			s1:         `[{"a":1,"b":1},{"a":2,"b":3}]`,
+			s2:         `[{"a":1},{"a":2,"b":3}]`,
+			want:       true,
+		},
+		{
+			name:       "policy document",
+			normalizer: PolicyDocumentNormalizer,
+			s1:         `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":["b","a"]}}`,
+			s2:         `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Principal":{"AWS":["123456789012"]},"Resource":["a","b"]}]}`,
+			want:       true,
+		},
+		{
+			name:       "ECS container definitions",
+			normalizer: ContainerDefinitionsNormalizer,
+			s1:         `[{"name":"app","essential":true,"cpu":0,"environment":[{"name":"B","value":"2"},{"name":"A","value":"1"}],"mountPoints":[],"portMappings":[{"containerPort":80,"hostPort":0,"protocol":"tcp"}],"user":null}]`,
+			s2:         `[{"name":"app","environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"portMappings":[{"containerPort":80}]}]`,
+			want:       true,
+		},
+		{
+			name:       "ECS container definitions host port",
+			normalizer: ContainerDefinitionsNormalizer,
+			s1:         `[{"name":"app","portMappings":[{"containerPort":80,"hostPort":80}]}]`,
+			s2:         `[{"name":"app","portMappings":[{"containerPort":80}]}]`,
+			want:       false,
+		},
+		{
+			name:       "ECS container definitions awsvpc host port",
+			normalizer: AWSVPCContainerDefinitionsNormalizer,
+			s1:         `[{"name":"app","portMappings":[{"containerPort":80,"hostPort":80}]}]`,
+			s2:         `[{"name":"app","portMappings":[{"containerPort":80}]}]`,
+			want:       true,
+		},
+		{
+			name:       "EventBridge pattern",
+			normalizer: EventPatternNormalizer,
+			s1:         `{"source":["aws.ec2"],"detail":{"state":["running","stopped"]}}`,
+			s2:         `{"detail":{"state":["stopped","running"]},"source":["aws.ec2"]}`,
+			want:       true,
+		},
+		{
+			name:       "EventBridge pattern content filters",
+			normalizer: EventPatternNormalizer,
+			s1:         `{"$or":[{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}]}},{"detail":{"state":[{"anything-but":["a","b"]},{"prefix":"x"}]}}]}`,
+			s2:         `{"$or":[{"detail":{"state":[{"prefix":"x"},{"anything-but":["a","b"]}]}},{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}]}}]}`,
+			want:       true,
+		},
+		{
+			name:       "EventBridge pattern numeric matcher",
+			normalizer: EventPatternNormalizer,
+			s1:         `{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}]}}`,
+			s2:         `{"detail":{"c-count":[{"numeric":[">",5,"<=",0]}]}}`,
+			want:       false,
+		},
+		{
+			name:       "CloudWatch dashboard body",
+			normalizer: DashboardBodyNormalizer,
+			s1:         `{"widgets":[{"type":"metric","properties":{"view":"timeSeries","stacked":false,"region":"us-west-2"}}]}`,
+			s2:         `{"widgets":[{"type":"metric","properties":{"region":"us-west-2"}}]}`,
+			want:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := testCase.normalizer.Equivalent(testCase.s1, testCase.s2)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestJSONNormalizerNormalize(t *testing.T) {
+	n := NewJSONNormalizer(WithUnorderedArrays("a"), WithDefaultValue("b", "x"))
+
+	got, err := n.Normalize(`{ "a": [3, 1, 2], "b": "x", "c": true }`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"a":[1,2,3],"c":true}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if _, err := n.Normalize(`{`); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestJSONNormalizerDiff(t *testing.T) {
+	n := NewJSONNormalizer(WithUnorderedArrays("tags"))
+
+	got, err := n.Diff(
+		`{"name":"a","tags":["x","y"],"items":[1,2],"nested":{"old":true}}`,
+		`{"name":"b","tags":["y","x"],"items":[1],"nested":{"new":true}}`,
+	)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		`items[1]: removed 2`,
+		`name: "a" => "b"`,
+		`nested.new: added true`,
+		`nested.old: removed true`,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	got, err = n.Diff(`[1]`, `{"a":1}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{`(root): [1] => {"a":1}`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestJSONNormalizerChangeWarnings(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+	n := NewJSONNormalizer(WithUnorderedArrays("tags"))
+
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"document": `{"name":"a","tags":["x","y"]}`,
+		},
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"document": {
+				Old: `{"name":"a","tags":["x","y"]}`,
+				New: `{"name":"b","tags":["y","x"]}`,
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := n.ChangeWarnings(d, "document")
+
+	if len(got) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(got))
+	}
+
+	if got[0].Severity != diag.Warning {
+		t.Errorf("got severity %v, want warning", got[0].Severity)
+	}
+
+	if want := `name: "a" => "b"`; got[0].Detail != want {
+		t.Errorf("got detail %q, want %q", got[0].Detail, want)
+	}
+
+	if got := n.ChangeWarnings(r.TestResourceData(), "document"); got != nil {
+		t.Errorf("got %v for an unchanged document, want none", got)
+	}
+}
+
+func TestJSONPathMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		path    []string
+		want    bool
+	}{
+		{"", []string{}, true},
+		{"a", []string{"a"}, true},
+		{"a", []string{"b"}, false},
+		{"a.*", []string{"a", "0"}, true},
+		{"a.*", []string{"a"}, false},
+		{"**", []string{}, true},
+		{"**.c", []string{"c"}, true},
+		{"**.c", []string{"a", "0", "c"}, true},
+		{"**.c", []string{"a", "c", "d"}, false},
+		{"a.**.c", []string{"a", "b", "c"}, true},
+	}
+
+	for _, testCase := range testCases {
+		if got := parseJSONPath(testCase.pattern).match(testCase.path); got != testCase.want {
+			t.Errorf("%q match %q: got %t, want %t", testCase.pattern, testCase.path, got, testCase.want)
+		}
+	}
+}
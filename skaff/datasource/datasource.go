@@ -24,6 +24,12 @@ var datasourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed datasourcefw.tmpl
+var datasourceFrameworkTmpl string
+
+//go:embed datasourcefwtest.tmpl
+var datasourceFrameworkTestTmpl string
+
 type TemplateData struct {
 	DataSource           string
 	DataSourceLower      string
@@ -36,9 +42,10 @@ type TemplateData struct {
 	AWSServiceName       string
 	AWSGoSDKV2           bool
 	HumanDataSourceName  string
+	PluginFramework      bool
 }
 
-func Create(dsName, snakeName string, comments, force, v2 bool) error {
+func Create(dsName, snakeName string, comments, force, v2, framework bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -87,6 +94,11 @@ func Create(dsName, snakeName string, comments, force, v2 bool) error {
 		AWSServiceName:       sn,
 		AWSGoSDKV2:           v2,
 		HumanDataSourceName:  resource.HumanResName(dsName),
+		PluginFramework:      framework,
+	}
+
+	if framework {
+		return createFramework(templateData, force)
 	}
 
 	f := fmt.Sprintf("%s_data_source.go", snakeName)
@@ -108,6 +120,37 @@ func Create(dsName, snakeName string, comments, force, v2 bool) error {
 	return nil
 }
 
+// createFramework writes a Terraform Plugin Framework data source, its acceptance tests and website documentation,
+// and registers the data source with the service package.
+func createFramework(td TemplateData, force bool) error {
+	snakeName := td.DataSourceSnake
+
+	f := fmt.Sprintf("%s_data_source.go", snakeName)
+	if err := writeTemplate("newds", f, datasourceFrameworkTmpl, force, td); err != nil {
+		return fmt.Errorf("writing datasource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_data_source_test.go", snakeName)
+	if err := writeTemplate("dstest", tf, datasourceFrameworkTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing datasource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing datasource website doc template: %w", err)
+	}
+
+	if err := resource.AddServicePackageDataDirective("generate.go", td.ServicePackage); err != nil {
+		return fmt.Errorf("adding service package data generate directive: %w", err)
+	}
+
+	fmt.Printf("Run `go generate` in this directory to register data source aws_%s_%s with the service package.\n", td.ServicePackage, snakeName)
+	fmt.Printf("If %[1]s has no other Plugin Framework resources or data sources, add %[1]s.ServicePackageData to internal/provider/provider.go.\n", td.ServicePackage)
+
+	return nil
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
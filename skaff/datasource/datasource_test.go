@@ -1 +1,55 @@
 package datasource
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"testing"
+	"text/template"
+)
+
+func TestFrameworkTemplates(t *testing.T) {
+	templates := map[string]string{
+		"datasource": datasourceFrameworkTmpl,
+		"test":       datasourceFrameworkTestTmpl,
+	}
+
+	for _, v2 := range []bool{false, true} {
+		for _, comments := range []bool{false, true} {
+			td := TemplateData{
+				DataSource:           "Widget",
+				DataSourceLower:      "widget",
+				DataSourceSnake:      "widget",
+				IncludeComments:      comments,
+				HumanFriendlyService: "Example",
+				ServicePackage:       "example",
+				Service:              "Example",
+				ServiceLower:         "example",
+				AWSServiceName:       "Example",
+				AWSGoSDKV2:           v2,
+				HumanDataSourceName:  "Widget",
+				PluginFramework:      true,
+			}
+
+			for name, tmpl := range templates {
+				name, tmpl := name, tmpl
+				t.Run(fmt.Sprintf("%s/v2=%t/comments=%t", name, v2, comments), func(t *testing.T) {
+					tplate, err := template.New(name).Parse(tmpl)
+					if err != nil {
+						t.Fatalf("parsing template: %s", err)
+					}
+
+					var buffer bytes.Buffer
+					if err := tplate.Execute(&buffer, td); err != nil {
+						t.Fatalf("executing template: %s", err)
+					}
+
+					if _, err := parser.ParseFile(token.NewFileSet(), name+".go", buffer.Bytes(), parser.ParseComments); err != nil {
+						t.Errorf("generated source does not parse: %s", err)
+					}
+				})
+			}
+		}
+	}
+}
@@ -24,6 +24,20 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourcefw.tmpl
+var resourceFrameworkTmpl string
+
+//go:embed resourcefwtest.tmpl
+var resourceFrameworkTestTmpl string
+
+//go:embed sweepfw.tmpl
+var sweepFrameworkTmpl string
+
+const (
+	servicePackageDataDirective = "//go:generate go run ../../generate/servicepackagedata/main.go"
+	generateFileComment         = "// ONLY generate directives and package declaration! Do not add anything else to this file."
+)
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -36,6 +50,7 @@ type TemplateData struct {
 	AWSServiceName       string
 	AWSGoSDKV2           bool
 	HumanResourceName    string
+	PluginFramework      bool
 }
 
 func ToSnakeCase(upper string, snakeName string) string {
@@ -58,7 +73,7 @@ func HumanResName(upper string) string {
 	return strings.TrimPrefix(re2.ReplaceAllString(upper, ` $1`), " ")
 }
 
-func Create(resName, snakeName string, comments, force, v2 bool) error {
+func Create(resName, snakeName string, comments, force, v2, framework bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -107,6 +122,11 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 		AWSServiceName:       sn,
 		AWSGoSDKV2:           v2,
 		HumanResourceName:    HumanResName(resName),
+		PluginFramework:      framework,
+	}
+
+	if framework {
+		return createFramework(templateData, force)
 	}
 
 	f := fmt.Sprintf("%s.go", snakeName)
@@ -128,6 +148,111 @@ func Create(resName, snakeName string, comments, force, v2 bool) error {
 	return nil
 }
 
+// createFramework writes a Terraform Plugin Framework resource, its acceptance tests, sweeper and website documentation,
+// and registers the resource with the service package.
+func createFramework(td TemplateData, force bool) error {
+	snakeName := td.ResourceSnake
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err := writeTemplate("newres", f, resourceFrameworkTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err := writeTemplate("restest", tf, resourceFrameworkTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	// Never overwrite an existing sweep.go, which contains the service's other sweepers.
+	sf := "sweep.go"
+	if _, err := os.Stat(sf); !errors.Is(err, fs.ErrNotExist) {
+		sf = fmt.Sprintf("%s_sweep.go", snakeName)
+	}
+	if err := writeTemplate("sweep", sf, sweepFrameworkTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource sweeper template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err := writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if err := AddServicePackageDataDirective("generate.go", td.ServicePackage); err != nil {
+		return fmt.Errorf("adding service package data generate directive: %w", err)
+	}
+
+	if err := addTestExport("exports_test.go", td.ServicePackage, fmt.Sprintf("Resource%[1]s = newResource%[1]s", td.Resource)); err != nil {
+		return fmt.Errorf("exporting resource for tests: %w", err)
+	}
+
+	fmt.Printf("Run `go generate` in this directory to register aws_%s_%s with the service package.\n", td.ServicePackage, snakeName)
+	fmt.Printf("If %[1]s has no other Plugin Framework resources or data sources, add %[1]s.ServicePackageData to internal/provider/provider.go.\n", td.ServicePackage)
+
+	return nil
+}
+
+// AddServicePackageDataDirective adds the internal/generate/servicepackagedata go:generate directive to a service package's
+// generate.go, creating the file if necessary. The generated code is used to register Plugin Framework resources and data sources.
+func AddServicePackageDataDirective(filename, servicePackage string) error {
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		contents := fmt.Sprintf("%s\n%s\n\npackage %s\n", servicePackageDataDirective, generateFileComment, servicePackage)
+
+		return os.WriteFile(filename, []byte(contents), 0644)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	contents := string(b)
+
+	if strings.Contains(contents, servicePackageDataDirective) {
+		return nil
+	}
+
+	// Directives come first, followed by the comment and package declaration.
+	lines := strings.Split(contents, "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], "//go:generate ") {
+		i++
+	}
+	lines = append(lines[:i], append([]string{servicePackageDataDirective}, lines[i:]...)...)
+
+	return os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644)
+}
+
+// addTestExport adds an export for use in tests only (e.g. "ResourceThing = newResourceThing") to a service package's
+// exports_test.go, creating the file if necessary.
+func addTestExport(filename, servicePackage, export string) error {
+	declaration := fmt.Sprintf("var %s\n", export)
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		contents := fmt.Sprintf("package %s\n\n// Exports for use in tests only.\n%s", servicePackage, declaration)
+
+		return os.WriteFile(filename, []byte(contents), 0644)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	contents := string(b)
+
+	if strings.Contains(contents, export) {
+		return nil
+	}
+
+	if !strings.HasSuffix(contents, "\n") {
+		contents += "\n"
+	}
+
+	return os.WriteFile(filename, []byte(contents+declaration), 0644)
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
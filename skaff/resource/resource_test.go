@@ -1,7 +1,14 @@
 package resource
 
 import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
 	"testing"
+	"text/template"
 )
 
 func TestToSnakeName(t *testing.T) {
@@ -101,3 +108,147 @@ func TestHumanResName(t *testing.T) {
 		})
 	}
 }
+
+func TestFrameworkTemplates(t *testing.T) {
+	templates := map[string]string{
+		"resource": resourceFrameworkTmpl,
+		"test":     resourceFrameworkTestTmpl,
+		"sweep":    sweepFrameworkTmpl,
+	}
+
+	for _, v2 := range []bool{false, true} {
+		for _, comments := range []bool{false, true} {
+			td := TemplateData{
+				Resource:             "Widget",
+				ResourceLower:        "widget",
+				ResourceSnake:        "widget",
+				HumanFriendlyService: "Example",
+				IncludeComments:      comments,
+				ServicePackage:       "example",
+				Service:              "Example",
+				ServiceLower:         "example",
+				AWSServiceName:       "Example",
+				AWSGoSDKV2:           v2,
+				HumanResourceName:    "Widget",
+				PluginFramework:      true,
+			}
+
+			for name, tmpl := range templates {
+				name, tmpl := name, tmpl
+				t.Run(fmt.Sprintf("%s/v2=%t/comments=%t", name, v2, comments), func(t *testing.T) {
+					tplate, err := template.New(name).Parse(tmpl)
+					if err != nil {
+						t.Fatalf("parsing template: %s", err)
+					}
+
+					var buffer bytes.Buffer
+					if err := tplate.Execute(&buffer, td); err != nil {
+						t.Fatalf("executing template: %s", err)
+					}
+
+					if _, err := parser.ParseFile(token.NewFileSet(), name+".go", buffer.Bytes(), parser.ParseComments); err != nil {
+						t.Errorf("generated source does not parse: %s", err)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestAddServicePackageDataDirective(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "no file",
+			Expected: servicePackageDataDirective + "\n" + generateFileComment + "\n\npackage example\n",
+		},
+		{
+			TestName: "other directives",
+			Input:    "//go:generate go run ../../generate/tags/main.go -ListTags\n" + generateFileComment + "\n\npackage example\n",
+			Expected: "//go:generate go run ../../generate/tags/main.go -ListTags\n" + servicePackageDataDirective + "\n" + generateFileComment + "\n\npackage example\n",
+		},
+		{
+			TestName: "already present",
+			Input:    servicePackageDataDirective + "\n" + generateFileComment + "\n\npackage example\n",
+			Expected: servicePackageDataDirective + "\n" + generateFileComment + "\n\npackage example\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "generate.go")
+
+			if testCase.Input != "" {
+				if err := os.WriteFile(filename, []byte(testCase.Input), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := AddServicePackageDataDirective(filename, "example"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := string(b); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAddTestExport(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "no file",
+			Expected: "package example\n\n// Exports for use in tests only.\nvar ResourceWidget = newResourceWidget\n",
+		},
+		{
+			TestName: "existing exports",
+			Input:    "package example\n\n// Exports for use in tests only.\nvar ResourceGadget = newResourceGadget",
+			Expected: "package example\n\n// Exports for use in tests only.\nvar ResourceGadget = newResourceGadget\nvar ResourceWidget = newResourceWidget\n",
+		},
+		{
+			TestName: "already present",
+			Input:    "package example\n\nvar (\n\tResourceWidget = newResourceWidget\n)\n",
+			Expected: "package example\n\nvar (\n\tResourceWidget = newResourceWidget\n)\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "exports_test.go")
+
+			if testCase.Input != "" {
+				if err := os.WriteFile(filename, []byte(testCase.Input), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := addTestExport(filename, "example", "ResourceWidget = newResourceWidget"); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			b, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := string(b); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
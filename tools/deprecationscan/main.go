@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
+	"github.com/mitchellh/cli"
+)
+
+var (
+	format = flag.String("format", "text", "Output format: text or json")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tdeprecationscan [flags] [directory]\n\n")
+	fmt.Fprintf(os.Stderr, "Scans the Terraform configuration in directory, default the current directory, and its subdirectories.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 1 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+
+	ui := &cli.BasicUi{
+		Reader:      os.Stdin,
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+	scanner := newScanner(deprecation.All())
+
+	if err := scanner.scanDir(dir); err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+
+	if *format == "json" {
+		usages := scanner.Usages
+
+		if usages == nil {
+			usages = []deprecatedUse{}
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(usages); err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+	} else {
+		for _, u := range scanner.Usages {
+			ui.Warn(u.String())
+		}
+	}
+
+	if n := len(scanner.Usages); n > 0 {
+		ui.Error(fmt.Sprintf("%d deprecated arguments and attributes used", n))
+		os.Exit(1)
+	}
+
+	if *format == "text" {
+		ui.Info("No deprecated arguments or attributes used")
+	}
+}
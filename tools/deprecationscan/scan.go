@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// deprecatedUse is a use of a deprecated argument or attribute in a Terraform configuration.
+type deprecatedUse struct {
+	Filename  string `json:"filename"`
+	Line      int    `json:"line"`
+	Address   string `json:"address"` // e.g. aws_s3_bucket.example, data.aws_example_thing.example or provider.aws.
+	Attribute string `json:"attribute"`
+	Reference bool   `json:"reference"` // Whether the attribute is referenced in an expression rather than configured.
+	Message   string `json:"message"`
+	Hint      string `json:"hint,omitempty"`
+}
+
+func (u deprecatedUse) String() string {
+	verb := "configures"
+
+	if u.Reference {
+		verb = "references"
+	}
+
+	s := fmt.Sprintf("%s:%d: %s %s %s: %s", u.Filename, u.Line, verb, u.Address, u.Attribute, u.Message)
+
+	if u.Hint != "" {
+		s += "\n    " + u.Hint
+	}
+
+	return s
+}
+
+type scanner struct {
+	Usages []deprecatedUse
+
+	deprecations map[string][]deprecation.Deprecation // By kind and type name, e.g. "resource aws_s3_bucket".
+}
+
+func newScanner(deprecations []deprecation.Deprecation) *scanner {
+	s := &scanner{
+		deprecations: make(map[string][]deprecation.Deprecation),
+	}
+
+	for _, d := range deprecations {
+		k := deprecationsKey(d.Kind, d.TypeName)
+		s.deprecations[k] = append(s.deprecations[k], d)
+	}
+
+	return s
+}
+
+func deprecationsKey(kind deprecation.Kind, typeName string) string {
+	return string(kind) + " " + typeName
+}
+
+// scanDir scans the Terraform configuration files (*.tf) in a directory and its subdirectories.
+// Hidden directories, e.g. .terraform, are skipped.
+func (s *scanner) scanDir(dir string) error {
+	parser := hclparse.NewParser()
+
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if e.IsDir() {
+			if path != dir && strings.HasPrefix(e.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != ".tf" {
+			return nil
+		}
+
+		file, diags := parser.ParseHCLFile(path)
+
+		if diags.HasErrors() {
+			return diags
+		}
+
+		s.scanFile(file)
+
+		return nil
+	})
+
+	sort.SliceStable(s.Usages, func(i, j int) bool {
+		if s.Usages[i].Filename != s.Usages[j].Filename {
+			return s.Usages[i].Filename < s.Usages[j].Filename
+		}
+
+		return s.Usages[i].Line < s.Usages[j].Line
+	})
+
+	return err
+}
+
+func (s *scanner) scanFile(file *hcl.File) {
+	body, ok := file.Body.(*hclsyntax.Body)
+
+	if !ok {
+		return
+	}
+
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == "resource" && len(block.Labels) == 2:
+			s.scanBlock(block, deprecation.KindResource, block.Labels[0], block.Labels[1], strings.Join(block.Labels, "."))
+		case block.Type == "data" && len(block.Labels) == 2:
+			s.scanBlock(block, deprecation.KindDataSource, block.Labels[0], block.Labels[1], "data."+strings.Join(block.Labels, "."))
+		case block.Type == "provider" && len(block.Labels) == 1 && block.Labels[0] == "aws":
+			s.scanBlock(block, deprecation.KindProvider, "", "", "provider.aws")
+		}
+	}
+
+	hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		if expr, ok := node.(*hclsyntax.ScopeTraversalExpr); ok {
+			s.scanTraversal(expr.Traversal)
+		}
+
+		return nil
+	})
+}
+
+// scanBlock reports the deprecated arguments configured in a resource, data source or provider block.
+func (s *scanner) scanBlock(block *hclsyntax.Block, kind deprecation.Kind, typeName, name, address string) {
+	values := literalValues(block.Body)
+
+	for _, d := range s.deprecations[deprecationsKey(kind, typeName)] {
+		for _, rng := range findArgument(block.Body, strings.Split(d.Attribute, ".")) {
+			s.Usages = append(s.Usages, deprecatedUse{
+				Filename:  rng.Filename,
+				Line:      rng.Start.Line,
+				Address:   address,
+				Attribute: d.Attribute,
+				Message:   d.Message(),
+				Hint:      hint(d, name, values),
+			})
+		}
+	}
+}
+
+// scanTraversal reports a reference to a deprecated resource or data source attribute,
+// e.g. aws_s3_bucket.example.website_endpoint or data.aws_example_thing.example[0].broken.
+func (s *scanner) scanTraversal(traversal hcl.Traversal) {
+	kind := deprecation.KindResource
+	steps := traversal
+
+	if traversal.RootName() == "data" {
+		kind = deprecation.KindDataSource
+		steps = traversal[1:]
+	}
+
+	var names []string
+
+	for i, step := range steps {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			names = append(names, step.Name)
+		case hcl.TraverseAttr:
+			names = append(names, step.Name)
+		case hcl.TraverseIndex:
+			if i == 2 && len(names) == 2 { // e.g. aws_s3_bucket.example[0].
+				continue
+			}
+
+			return
+		}
+
+		if len(names) == 3 {
+			break
+		}
+	}
+
+	if len(names) < 3 {
+		return
+	}
+
+	typeName, name, attribute := names[0], names[1], names[2]
+
+	for _, d := range s.deprecations[deprecationsKey(kind, typeName)] {
+		if d.Attribute != attribute {
+			continue
+		}
+
+		address := typeName + "." + name
+
+		if kind == deprecation.KindDataSource {
+			address = "data." + address
+		}
+
+		rng := traversal.SourceRange()
+
+		s.Usages = append(s.Usages, deprecatedUse{
+			Filename:  rng.Filename,
+			Line:      rng.Start.Line,
+			Address:   address,
+			Attribute: d.Attribute,
+			Reference: true,
+			Message:   d.Message(),
+			Hint:      referenceHint(d, name),
+		})
+	}
+}
+
+// findArgument returns the ranges of an argument or nested block, including dynamic blocks, configured in a body.
+func findArgument(body *hclsyntax.Body, path []string) []hcl.Range {
+	var ranges []hcl.Range
+	name := path[0]
+
+	if attribute, ok := body.Attributes[name]; ok && len(path) == 1 {
+		ranges = append(ranges, attribute.NameRange)
+	}
+
+	for _, block := range body.Blocks {
+		var content *hclsyntax.Body
+
+		switch {
+		case block.Type == name:
+			content = block.Body
+		case block.Type == "dynamic" && len(block.Labels) == 1 && block.Labels[0] == name:
+			for _, b := range block.Body.Blocks {
+				if b.Type == "content" {
+					content = b.Body
+				}
+			}
+		default:
+			continue
+		}
+
+		if len(path) == 1 {
+			ranges = append(ranges, block.DefRange())
+		} else if content != nil {
+			ranges = append(ranges, findArgument(content, path[1:])...)
+		}
+	}
+
+	return ranges
+}
+
+// literalValues returns the values of a body's arguments which are string, number or boolean literals.
+func literalValues(body *hclsyntax.Body) map[string]string {
+	values := make(map[string]string)
+
+	for name, attribute := range body.Attributes {
+		if len(attribute.Expr.Variables()) > 0 {
+			continue
+		}
+
+		v, diags := attribute.Expr.Value(nil)
+
+		if diags.HasErrors() || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		switch v.Type() {
+		case cty.String:
+			values[name] = v.AsString()
+		case cty.Number:
+			values[name] = v.AsBigFloat().Text('f', -1)
+		case cty.Bool:
+			values[name] = fmt.Sprint(v.True())
+		}
+	}
+
+	return values
+}
+
+// hint returns how to migrate a configured deprecated argument.
+func hint(d deprecation.Deprecation, name string, values map[string]string) string {
+	if v := d.ImportCommand(name, values); v != "" {
+		return v
+	}
+
+	return referenceHint(d, name)
+}
+
+// referenceHint returns what to reference instead of a deprecated attribute.
+func referenceHint(d deprecation.Deprecation, name string) string {
+	switch {
+	case d.ReplacementResource != "" && d.ReplacementResourceAttribute != "":
+		prefix := ""
+
+		if d.Kind == deprecation.KindDataSource {
+			prefix = "data."
+		}
+
+		return fmt.Sprintf("use %s%s.%s.%s", prefix, d.ReplacementResource, name, d.ReplacementResourceAttribute)
+	case d.ReplacementAttribute != "":
+		return fmt.Sprintf("use %s", d.ReplacementAttribute)
+	}
+
+	return ""
+}
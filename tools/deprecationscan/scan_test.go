@@ -0,0 +1,126 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/deprecation"
+)
+
+const testConfig = `
+provider "aws" {
+  region                  = "us-west-2"
+  shared_credentials_file = "~/.aws/credentials"
+
+  assume_role {
+    role_arn         = "arn:aws:iam::123456789012:role/example"
+    duration_seconds = 3600
+  }
+}
+
+resource "aws_s3_bucket" "example" {
+  bucket = "example-bucket"
+  acl    = "private"
+
+  versioning {
+    enabled = true
+  }
+
+  dynamic "cors_rule" {
+    for_each = var.cors_rules
+
+    content {
+      allowed_methods = cors_rule.value.methods
+      allowed_origins = cors_rule.value.origins
+    }
+  }
+
+  object_lock_configuration {
+    object_lock_enabled = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "computed" {
+  bucket_prefix = "example-"
+  policy        = data.aws_iam_policy_document.example.json
+}
+
+resource "aws_route53_record" "example" {
+  name    = aws_s3_bucket.example.website_endpoint
+  records = [aws_s3_bucket.computed[0].bucket]
+}
+`
+
+func TestScanDir(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(dir, "main.tf"), testConfig)
+	writeTestFile(t, filepath.Join(dir, "modules", "example", "main.tf"), `resource "aws_s3_bucket" "nested" { request_payer = "Requester" }`)
+	writeTestFile(t, filepath.Join(dir, ".terraform", "modules", "remote", "main.tf"), `resource "aws_s3_bucket" "ignored" { acl = "private" }`)
+	writeTestFile(t, filepath.Join(dir, "README.md"), `resource "aws_s3_bucket" "ignored" { acl = "private" }`)
+
+	s := newScanner(deprecation.All())
+
+	if err := s.scanDir(dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+
+	for _, u := range s.Usages {
+		got = append(got, strings.Join([]string{
+			strings.TrimPrefix(filepath.ToSlash(strings.TrimPrefix(u.Filename, dir)), "/"),
+			u.Address,
+			u.Attribute,
+			u.Hint,
+		}, " | "))
+	}
+
+	expected := []string{
+		"main.tf | provider.aws | shared_credentials_file | use shared_credentials_files",
+		"main.tf | provider.aws | assume_role.duration_seconds | use assume_role.duration",
+		"main.tf | aws_s3_bucket.example | acl | terraform import aws_s3_bucket_acl.example example-bucket,private",
+		"main.tf | aws_s3_bucket.example | versioning | terraform import aws_s3_bucket_versioning.example example-bucket",
+		"main.tf | aws_s3_bucket.example | cors_rule | terraform import aws_s3_bucket_cors_configuration.example example-bucket",
+		"main.tf | aws_s3_bucket.example | object_lock_configuration | terraform import aws_s3_bucket_object_lock_configuration.example example-bucket",
+		"main.tf | aws_s3_bucket.example | object_lock_configuration.object_lock_enabled | use object_lock_enabled",
+		"main.tf | aws_s3_bucket.computed | policy | terraform import aws_s3_bucket_policy.computed <bucket>",
+		"main.tf | aws_s3_bucket.example | website_endpoint | use aws_s3_bucket_website_configuration.example.website_endpoint",
+		"modules/example/main.tf | aws_s3_bucket.nested | request_payer | terraform import aws_s3_bucket_request_payment_configuration.nested <bucket>",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got usages\n%s\nexpected\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+
+	for _, u := range s.Usages {
+		if got, expected := u.Reference, u.Attribute == "website_endpoint"; got != expected {
+			t.Errorf("%s %s: got reference %t, expected %t", u.Address, u.Attribute, got, expected)
+		}
+	}
+}
+
+func TestScanDir_invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTestFile(t, filepath.Join(dir, "main.tf"), `resource "aws_s3_bucket" {`)
+
+	if err := newScanner(deprecation.All()).scanDir(dir); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
+
+func writeTestFile(t *testing.T, filename, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
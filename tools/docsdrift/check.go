@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// finding is a difference between a schema and its documentation.
+type finding struct {
+	path    string
+	message string
+	line    int // Documentation line, if any.
+}
+
+func (f finding) String() string {
+	if f.line > 0 {
+		return fmt.Sprintf("%s: %s (line %d)", f.path, f.message, f.line)
+	}
+
+	return fmt.Sprintf("%s: %s", f.path, f.message)
+}
+
+// checkDoc returns the differences between a resource or data source's schema elements and its documentation:
+//
+//   - Attributes and blocks that are not documented. Deprecated ones need not be.
+//   - Documented attributes and blocks that are not in the schema.
+//   - Attributes and blocks documented as (Required) or (Optional) that are not, or are read-only.
+//   - Deprecated attributes and blocks documented without a deprecation notice.
+//
+// Nested block attributes are matched to entries under a subsection heading, or block introduction, mentioning the block.
+// If there is no such entry, an entry with the same name anywhere on the page is accepted, but its flags are not checked.
+func checkDoc(elements []element, d *doc) []finding {
+	var findings []finding
+
+	byPath := make(map[string]element, len(elements))
+	names := make(map[string]bool, len(elements))
+	var blocks []element
+
+	for _, e := range elements {
+		byPath[e.path] = e
+		names[e.name()] = true
+
+		if e.isBlock {
+			blocks = append(blocks, e)
+		}
+	}
+
+	entriesByName := make(map[string][]docEntry)
+	for _, entry := range d.entries {
+		entriesByName[entry.name] = append(entriesByName[entry.name], entry)
+	}
+
+	// mentionsAnyBlock returns whether a subsection is about a nested block.
+	mentionsAnyBlock := func(subsection string) bool {
+		for _, b := range blocks {
+			if subsectionMentionsBlock(subsection, b.name()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, e := range elements {
+		if implicitElements[strings.SplitN(e.path, ".", 2)[0]] {
+			continue
+		}
+
+		// Entries under a subsection exactly about the element's block are preferred to those under a subsection mentioning it.
+		var exact, strict, loose []docEntry
+
+		for _, entry := range entriesByName[e.name()] {
+			loose = append(loose, entry)
+
+			if parent := e.parent(); parent == "" {
+				if entry.subsection == "" || !mentionsAnyBlock(entry.subsection) {
+					strict = append(strict, entry)
+				}
+			} else if name := byPath[parent].name(); subsectionIsBlock(entry.subsection, name) {
+				exact = append(exact, entry)
+			} else if subsectionMentionsBlock(entry.subsection, name) {
+				strict = append(strict, entry)
+			}
+		}
+
+		if len(exact) > 0 {
+			strict = exact
+		}
+
+		if len(loose) == 0 {
+			if !e.deprecated {
+				findings = append(findings, finding{path: e.path, message: "not documented (" + describe(e) + ")"})
+			}
+
+			continue
+		}
+
+		entry := loose[0]
+		if len(strict) > 0 {
+			entry = strict[0]
+		}
+
+		if e.deprecated && !entry.deprecated {
+			findings = append(findings, finding{path: e.path, message: "deprecated, but documented without a deprecation notice", line: entry.line})
+		}
+
+		if len(strict) == 0 {
+			continue
+		}
+
+		// If the element is documented more than once, e.g. for blocks of the same name, any correctly flagged entry suffices.
+		var message string
+		for _, entry := range strict {
+			message = misflagged(e, entry)
+
+			if message == "" {
+				break
+			}
+		}
+
+		if message != "" {
+			findings = append(findings, finding{path: e.path, message: message, line: entry.line})
+		}
+	}
+
+	// Documented entries not in the schema.
+	type key struct{ name, subsection string }
+	seen := make(map[key]bool)
+
+	for _, entry := range d.entries {
+		k := key{entry.name, entry.subsection}
+		// Entries naming resource types, e.g. in lists of supported resources, are not attributes.
+		if seen[k] || implicitElements[entry.name] || strings.HasPrefix(entry.name, "aws_") {
+			continue
+		}
+		seen[k] = true
+
+		if entry.subsection == "" {
+			// Nested block attributes documented at the top level are accepted.
+			if !names[entry.name] {
+				findings = append(findings, finding{path: entry.name, message: "documented in " + entry.section.String() + ", but not in schema", line: entry.line})
+			}
+
+			continue
+		}
+
+		// Only entries in a subsection that is unambiguously about one or more blocks of the same name are checked.
+		var parents []element
+		for _, b := range blocks {
+			if subsectionIsBlock(entry.subsection, b.name()) {
+				parents = append(parents, b)
+			}
+		}
+
+		if len(parents) == 0 {
+			continue
+		}
+
+		found := false
+		for _, b := range parents {
+			if _, ok := byPath[b.path+"."+entry.name]; ok {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			findings = append(findings, finding{path: parents[0].path + "." + entry.name, message: "documented in " + entry.section.String() + ", but not in schema", line: entry.line})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].path < findings[j].path
+	})
+
+	return findings
+}
+
+// misflagged returns a description of how an entry's (Required) or (Optional) flag is wrong, or "" if it is not.
+func misflagged(e element, entry docEntry) string {
+	switch {
+	case entry.required && entry.optional:
+		// Conditionally required.
+		return ""
+	case entry.required && !e.required:
+		return "documented as Required, but is " + describe(e)
+	case entry.optional && !e.optional:
+		return "documented as Optional, but is " + describe(e)
+	default:
+		return ""
+	}
+}
+
+// describe returns a short description of an element's configurability, e.g. "Optional block".
+func describe(e element) string {
+	var s string
+
+	switch {
+	case e.required:
+		s = "Required"
+	case e.optional && e.computed:
+		s = "Optional, Computed"
+	case e.optional:
+		s = "Optional"
+	default:
+		s = "read-only"
+	}
+
+	if e.isBlock {
+		return s + " block"
+	}
+
+	return s + " attribute"
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/mitchellh/cli"
+)
+
+var testSchema = &tfprotov5.Schema{
+	Block: &tfprotov5.SchemaBlock{
+		Attributes: []*tfprotov5.SchemaAttribute{
+			{Name: "arn", Type: tftypes.String, Computed: true},
+			{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+			{Name: "name", Type: tftypes.String, Required: true},
+			{Name: "old_name", Type: tftypes.String, Optional: true, Deprecated: true},
+			{Name: "description", Type: tftypes.String, Optional: true},
+			{Name: "undocumented", Type: tftypes.String, Optional: true},
+			{Name: "legacy", Type: tftypes.String, Optional: true, Deprecated: true},
+		},
+		BlockTypes: []*tfprotov5.SchemaNestedBlock{
+			{
+				TypeName: "filter",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSet,
+				MinItems: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "criteria", Type: tftypes.String, Required: true},
+						{Name: "name", Type: tftypes.String, Optional: true},
+						{Name: "status", Type: tftypes.String, Computed: true},
+					},
+				},
+			},
+			{
+				TypeName: "timeouts",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeSingle,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "create", Type: tftypes.String, Optional: true},
+					},
+				},
+			},
+		},
+	},
+}
+
+const testDoc = "# Resource: aws_test_thing\n" +
+	"\n" +
+	"## Example Usage\n" +
+	"\n" +
+	"```terraform\n" +
+	"* `in_code` - (Required) Not an entry.\n" +
+	"```\n" +
+	"\n" +
+	"## Argument Reference\n" +
+	"\n" +
+	"The following arguments are supported:\n" +
+	"\n" +
+	"* `name` - (Optional) Name.\n" +
+	"* `old_name` - (Optional) Old name.\n" +
+	"* `description` - (Optional) Description.\n" +
+	"* `filter` - (Required) Filter. See [Filter](#filter) below.\n" +
+	"* `removed` - (Optional) No longer exists.\n" +
+	"\n" +
+	"### Filter\n" +
+	"\n" +
+	"* `criteria` - (Optional) Criteria.\n" +
+	"* `name` - (Required) Name.\n" +
+	"* `gone` - (Optional) No longer exists.\n" +
+	"\n" +
+	"## Attributes Reference\n" +
+	"\n" +
+	"In addition to all arguments above, the following attributes are exported:\n" +
+	"\n" +
+	"* `id` - ID.\n" +
+	"* `arn` - (Optional) ARN.\n" +
+	"\n" +
+	"The `filter` block exports:\n" +
+	"\n" +
+	"* `status` - Status.\n" +
+	"\n" +
+	"## Timeouts\n" +
+	"\n" +
+	"* `create` - (Default `10m`)\n"
+
+func TestParseDoc(t *testing.T) {
+	d, err := parseDoc(strings.NewReader(testDoc))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, entry := range d.entries {
+		got = append(got, entry.section.String()+"/"+entry.subsection+"/"+entry.name)
+	}
+
+	want := []string{
+		"Argument Reference//name",
+		"Argument Reference//old_name",
+		"Argument Reference//description",
+		"Argument Reference//filter",
+		"Argument Reference//removed",
+		"Argument Reference/filter/criteria",
+		"Argument Reference/filter/name",
+		"Argument Reference/filter/gone",
+		"Attributes Reference//id",
+		"Attributes Reference//arn",
+		"Attributes Reference/filter/status",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if entry := d.entries[3]; !entry.required || entry.optional {
+		t.Errorf("filter flags: got required %t, optional %t", entry.required, entry.optional)
+	}
+}
+
+func TestCheckDoc(t *testing.T) {
+	d, err := parseDoc(strings.NewReader(testDoc))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range checkDoc(schemaElements(testSchema), d) {
+		got = append(got, f.String())
+	}
+
+	want := []string{
+		"arn: documented as Optional, but is read-only attribute (line 30)",
+		"filter.criteria: documented as Optional, but is Required attribute (line 21)",
+		"filter.gone: documented in Argument Reference, but not in schema (line 23)",
+		"filter.name: documented as Required, but is Optional attribute (line 22)",
+		"name: documented as Optional, but is Required attribute (line 13)",
+		"old_name: deprecated, but documented without a deprecation notice (line 14)",
+		"removed: documented in Argument Reference, but not in schema (line 17)",
+		"undocumented: not documented (Optional attribute)",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWriteSkeletonDoc(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "test_other.html.markdown"), []byte("---\nsubcategory: \"Test\"\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(dir, "test_thing.html.markdown")
+	elements := schemaElements(testSchema)
+
+	if err := writeSkeletonDoc(filename, "aws_test_thing", false, elements); err != nil {
+		t.Fatal(err)
+	}
+
+	contents, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`subcategory: "Test"`,
+		"# Resource: aws_test_thing",
+		"* `name` - (Required) TODO.",
+		"* `filter` - (Required) TODO. See [filter](#filter) below.",
+		"### filter",
+		"* `criteria` - (Required) TODO.",
+		"* `arn` - TODO.",
+		"* `create` - (Default `TODO`)",
+		"$ terraform import aws_test_thing.example TODO",
+	} {
+		if !strings.Contains(string(contents), want) {
+			t.Errorf("skeleton does not contain %q:\n%s", want, contents)
+		}
+	}
+
+	if strings.Contains(string(contents), "legacy") {
+		t.Errorf("skeleton contains deprecated attribute:\n%s", contents)
+	}
+
+	// A skeleton doc has no drift, other than deprecated attributes, which are never listed.
+	d, err := parseDoc(strings.NewReader(string(contents)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if findings := checkDoc(elements, d); len(findings) != 0 {
+		t.Errorf("unexpected findings: %v", findings)
+	}
+}
+
+func TestCheckerCheck(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, "r"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Some pages are named <name>.markdown rather than <name>.html.markdown.
+	if err := writeSkeletonDoc(filepath.Join(dir, "r", "test_thing.markdown"), "aws_test_thing", false, schemaElements(testSchema)); err != nil {
+		t.Fatal(err)
+	}
+
+	ui := cli.NewMockUi()
+	c := &checker{
+		DocsDir: dir,
+		Ui:      ui,
+	}
+
+	c.check("r", "resource", map[string]*tfprotov5.Schema{
+		"aws_test_missing": testSchema,
+		"aws_test_thing":   testSchema,
+	})
+
+	if got, want := c.Checked, 2; got != want {
+		t.Errorf("got %d checked, want %d", got, want)
+	}
+
+	if got, want := c.Drifted, 1; got != want {
+		t.Errorf("got %d drifted, want %d", got, want)
+	}
+
+	if output := ui.ErrorWriter.String(); !strings.Contains(output, "test_missing.html.markdown not found") || strings.Contains(output, "test_thing") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+
+	// With -skeleton, a page is only written where neither form exists.
+	ui = cli.NewMockUi()
+	c = &checker{
+		DocsDir:  dir,
+		Skeleton: true,
+		Ui:       ui,
+	}
+
+	c.check("r", "resource", map[string]*tfprotov5.Schema{
+		"aws_test_missing": testSchema,
+		"aws_test_thing":   testSchema,
+	})
+
+	if got, want := c.Drifted, 0; got != want {
+		t.Errorf("got %d drifted, want %d:\n%s", got, want, ui.ErrorWriter.String())
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "r", "test_missing.html.markdown")); err != nil {
+		t.Errorf("skeleton not written: %s", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "r", "test_thing.html.markdown")); !os.IsNotExist(err) {
+		t.Errorf("duplicate skeleton written for test_thing.markdown: %v", err)
+	}
+}
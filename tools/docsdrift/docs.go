@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// docSection identifies a documentation section containing attribute entries.
+type docSection int
+
+const (
+	sectionNone docSection = iota
+	sectionArguments
+	sectionAttributes
+)
+
+func (s docSection) String() string {
+	switch s {
+	case sectionArguments:
+		return "Argument Reference"
+	case sectionAttributes:
+		return "Attributes Reference"
+	default:
+		return ""
+	}
+}
+
+// docEntry is a documented attribute or block, a bullet such as
+//
+//   - `name` - (Required) Name of the thing.
+type docEntry struct {
+	name       string
+	section    docSection
+	subsection string // Normalized heading, block introduction or enclosing entry the entry appears under, "" at the top level of a section.
+	required   bool   // "(Required...)".
+	optional   bool   // "(Optional...)". Both may be set, e.g. "(Optional, Required when ...)".
+	deprecated bool   // Mentions deprecation.
+	line       int
+}
+
+// doc is a parsed resource or data source documentation page.
+type doc struct {
+	entries []docEntry
+}
+
+var (
+	// * `name` - (Optional) Description.
+	entryRegexp = regexp.MustCompile("^\\s*[*-]\\s+`([a-zA-Z0-9_]+)`\\s*(?:-\\s*)?(?:\\(([^)]*)\\))?(.*)$")
+
+	// The `name` block supports:
+	blockIntroRegexp = regexp.MustCompile("(?i)^\\s*(?:the\\s+|each\\s+)?`([a-zA-Z0-9_]+)`\\s+(?:configuration\\s+)?(?:blocks?\\s+|objects?\\s+)?(?:supports|contains|exports|has|have|includes?)\\b")
+
+	nonAlphanumericRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// parseDoc parses the Argument Reference and Attributes Reference sections of a documentation page.
+// Entries in fenced code blocks are ignored.
+func parseDoc(r io.Reader) (*doc, error) {
+	d := &doc{}
+	scanner := bufio.NewScanner(r)
+	section := sectionNone
+	subsection := ""
+	inCode := false
+	line := 0
+
+	type indentedEntry struct {
+		indent int
+		name   string
+	}
+	var parents []indentedEntry
+
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(text), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			continue
+		}
+
+		if strings.HasPrefix(text, "#") {
+			level := len(text) - len(strings.TrimLeft(text, "#"))
+			heading := strings.TrimSpace(strings.TrimLeft(text, "#"))
+
+			if level <= 2 {
+				section = headingSection(heading)
+				subsection = ""
+			} else {
+				subsection = normalizeHeading(heading)
+			}
+			parents = nil
+
+			continue
+		}
+
+		if section == sectionNone {
+			continue
+		}
+
+		if m := entryRegexp.FindStringSubmatch(text); m != nil {
+			flags := strings.ToLower(m[2])
+			rest := strings.ToLower(m[3])
+
+			// Indented entries are nested in the preceding, less indented, entry.
+			indent := len(text) - len(strings.TrimLeft(text, " \t"))
+			for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+				parents = parents[:len(parents)-1]
+			}
+
+			entrySubsection := subsection
+			if len(parents) > 0 {
+				entrySubsection = normalizeHeading(parents[len(parents)-1].name)
+			}
+			parents = append(parents, indentedEntry{indent: indent, name: m[1]})
+
+			d.entries = append(d.entries, docEntry{
+				name:       m[1],
+				section:    section,
+				subsection: entrySubsection,
+				required:   strings.Contains(flags, "required"),
+				optional:   strings.Contains(flags, "optional"),
+				deprecated: strings.Contains(flags, "deprecated") || strings.Contains(rest, "deprecated"),
+				line:       line,
+			})
+
+			continue
+		}
+
+		if m := blockIntroRegexp.FindStringSubmatch(text); m != nil {
+			subsection = normalizeHeading(m[1])
+			parents = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func headingSection(heading string) docSection {
+	switch h := strings.ToLower(heading); {
+	case strings.HasPrefix(h, "argument"):
+		return sectionArguments
+	case strings.HasPrefix(h, "attribute"):
+		return sectionAttributes
+	default:
+		return sectionNone
+	}
+}
+
+// normalizeHeading returns a heading in snake case, e.g. "`ingress` Configuration Block" -> "ingress_configuration_block".
+func normalizeHeading(heading string) string {
+	return strings.Trim(nonAlphanumericRegexp.ReplaceAllString(strings.ToLower(heading), "_"), "_")
+}
+
+// Heading suffixes commonly used for nested block subsections.
+var blockHeadingSuffixes = []string{"_configuration_block", "_configuration_blocks", "_block", "_blocks", "_configuration", "_arguments", "_attributes"}
+
+// subsectionIsBlock returns whether a normalized subsection heading is exactly that of the named block.
+func subsectionIsBlock(subsection, name string) bool {
+	if subsection == name {
+		return true
+	}
+
+	for _, suffix := range blockHeadingSuffixes {
+		if strings.TrimSuffix(subsection, suffix) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subsectionMentionsBlock returns whether a normalized subsection heading mentions the named block.
+func subsectionMentionsBlock(subsection, name string) bool {
+	return subsection == name ||
+		strings.HasPrefix(subsection, name+"_") ||
+		strings.HasSuffix(subsection, "_"+name) ||
+		strings.Contains(subsection, "_"+name+"_")
+}
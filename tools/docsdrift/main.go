@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/mitchellh/cli"
+)
+
+var (
+	dataSourceType = flag.String("data-source", "", "Check only the specified data source type")
+	docsDir        = flag.String("docs", "website/docs", "Path of the website documentation directory")
+	ignore         = flag.String("ignore", "", "Comma-separated list of resource and data source types not to check")
+	resourceType   = flag.String("resource", "", "Check only the specified resource type")
+	skeleton       = flag.Bool("skeleton", false, "Write a skeleton documentation page for each resource or data source without one")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tdocsdrift [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ui := &cli.BasicUi{
+		Reader:      os.Stdin,
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+	checker := &checker{
+		DocsDir:  *docsDir,
+		Ignore:   make(map[string]bool),
+		Skeleton: *skeleton,
+		Ui:       ui,
+	}
+
+	for _, v := range strings.Split(*ignore, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			checker.Ignore[v] = true
+		}
+	}
+
+	ctx := context.Background()
+	schemas, err := providerSchemas(ctx)
+
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+
+	resources, dataSources := schemas.ResourceSchemas, schemas.DataSourceSchemas
+
+	switch {
+	case *resourceType != "":
+		v, ok := resources[*resourceType]
+
+		if !ok {
+			ui.Error(fmt.Sprintf("resource type %s not found", *resourceType))
+			os.Exit(2)
+		}
+
+		resources, dataSources = map[string]*tfprotov5.Schema{*resourceType: v}, nil
+	case *dataSourceType != "":
+		v, ok := dataSources[*dataSourceType]
+
+		if !ok {
+			ui.Error(fmt.Sprintf("data source type %s not found", *dataSourceType))
+			os.Exit(2)
+		}
+
+		resources, dataSources = nil, map[string]*tfprotov5.Schema{*dataSourceType: v}
+	}
+
+	checker.check("r", "resource", resources)
+	checker.check("d", "data source", dataSources)
+
+	if checker.Drifted > 0 {
+		ui.Error(fmt.Sprintf("%d of %d pages have drifted from their schema", checker.Drifted, checker.Checked))
+		os.Exit(1)
+	}
+
+	ui.Info(fmt.Sprintf("%d pages checked", checker.Checked))
+}
+
+// providerSchemas returns the schemas of all resources and data sources, loading the provider through
+// both provider.New (Plugin SDK) and fwprovider.New (Plugin Framework), muxed exactly as they are served to Terraform.
+func providerSchemas(ctx context.Context) (*tfprotov5.GetProviderSchemaResponse, error) {
+	factory, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range response.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("reading provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return response, nil
+}
+
+type checker struct {
+	Checked  int
+	DocsDir  string
+	Drifted  int
+	Ignore   map[string]bool
+	Skeleton bool
+	Ui       cli.Ui
+}
+
+// check compares each schema with its documentation page, e.g. website/docs/r/accessanalyzer_analyzer.html.markdown.
+// Pages named without the .html infix, e.g. website/docs/r/codepipeline.markdown, are also recognized.
+func (c *checker) check(subdir, kind string, schemas map[string]*tfprotov5.Schema) {
+	typeNames := make([]string, 0, len(schemas))
+	for typeName := range schemas {
+		if !c.Ignore[typeName] {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		elements := schemaElements(schemas[typeName])
+		name := strings.TrimPrefix(typeName, "aws_")
+
+		c.Checked++
+
+		filename, err := docFilename(filepath.Join(c.DocsDir, subdir), name)
+
+		if err != nil {
+			c.Drifted++
+			c.Ui.Error(fmt.Sprintf("%s %s: %s", kind, typeName, err))
+
+			continue
+		}
+
+		if filename == "" {
+			filename = filepath.Join(c.DocsDir, subdir, name+".html.markdown")
+
+			if c.Skeleton {
+				if err := writeSkeletonDoc(filename, typeName, subdir == "d", elements); err != nil {
+					c.Drifted++
+					c.Ui.Error(fmt.Sprintf("%s %s: writing skeleton documentation: %s", kind, typeName, err))
+
+					continue
+				}
+
+				c.Ui.Info(fmt.Sprintf("%s %s: wrote skeleton documentation to %s", kind, typeName, filename))
+
+				continue
+			}
+
+			c.Drifted++
+			c.Ui.Warn(fmt.Sprintf("%s %s: %s not found", kind, typeName, filename))
+
+			continue
+		}
+
+		file, err := os.Open(filename)
+
+		if err != nil {
+			c.Drifted++
+			c.Ui.Error(fmt.Sprintf("%s %s: %s", kind, typeName, err))
+
+			continue
+		}
+
+		d, err := parseDoc(file)
+		file.Close()
+
+		if err != nil {
+			c.Drifted++
+			c.Ui.Error(fmt.Sprintf("%s %s: reading %s: %s", kind, typeName, filename, err))
+
+			continue
+		}
+
+		findings := checkDoc(elements, d)
+
+		if len(findings) == 0 {
+			continue
+		}
+
+		c.Drifted++
+		c.Ui.Warn(fmt.Sprintf("%s %s (%s):", kind, typeName, filename))
+		for _, f := range findings {
+			c.Ui.Warn("  " + f.String())
+		}
+	}
+}
+
+// docFilename returns the path of the existing documentation page for name in dir, or "" if there is none.
+// Pages are named either <name>.html.markdown or <name>.markdown.
+func docFilename(dir, name string) (string, error) {
+	for _, ext := range []string{".html.markdown", ".markdown"} {
+		filename := filepath.Join(dir, name+ext)
+
+		_, err := os.Stat(filename)
+
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		return filename, nil
+	}
+
+	return "", nil
+}
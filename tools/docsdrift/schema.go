@@ -0,0 +1,90 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// Attributes and blocks that are never expected to be documented in the argument or attribute references.
+var implicitElements = map[string]bool{
+	"id":       true, // Implicitly added by the Plugin SDK.
+	"timeouts": true, // Documented in its own section.
+}
+
+// element is the documentable part of an attribute or block.
+type element struct {
+	path       string // e.g. "filter.criteria"
+	isBlock    bool
+	required   bool
+	optional   bool
+	computed   bool
+	deprecated bool
+}
+
+// name returns the element's name, the last part of its path.
+func (e element) name() string {
+	return e.path[strings.LastIndex(e.path, ".")+1:]
+}
+
+// parent returns the path of the element's enclosing block, or "" for a top-level element.
+func (e element) parent() string {
+	if i := strings.LastIndex(e.path, "."); i >= 0 {
+		return e.path[:i]
+	}
+
+	return ""
+}
+
+// isArgument returns whether the element can be configured.
+func (e element) isArgument() bool {
+	return e.required || e.optional
+}
+
+// schemaElements returns all attributes and blocks in a Terraform protocol schema, sorted by path.
+// The schema is that sent to Terraform, so Plugin SDK and Plugin Framework schemas are treated alike.
+func schemaElements(schema *tfprotov5.Schema) []element {
+	var elements []element
+
+	if schema != nil {
+		walkSchemaBlock("", schema.Block, &elements)
+	}
+
+	sort.Slice(elements, func(i, j int) bool {
+		return elements[i].path < elements[j].path
+	})
+
+	return elements
+}
+
+func walkSchemaBlock(prefix string, block *tfprotov5.SchemaBlock, elements *[]element) {
+	if block == nil {
+		return
+	}
+
+	for _, attribute := range block.Attributes {
+		*elements = append(*elements, element{
+			path:       prefix + attribute.Name,
+			required:   attribute.Required,
+			optional:   attribute.Optional,
+			computed:   attribute.Computed,
+			deprecated: attribute.Deprecated,
+		})
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		path := prefix + nestedBlock.TypeName
+		deprecated := nestedBlock.Block != nil && nestedBlock.Block.Deprecated
+
+		*elements = append(*elements, element{
+			path:       path,
+			isBlock:    true,
+			required:   nestedBlock.MinItems > 0,
+			optional:   nestedBlock.MinItems == 0,
+			deprecated: deprecated,
+		})
+
+		walkSchemaBlock(path+".", nestedBlock.Block, elements)
+	}
+}
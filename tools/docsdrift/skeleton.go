@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+//go:embed resourcedoc.tmpl
+var resourceDocTmpl string
+
+//go:embed datasourcedoc.tmpl
+var dataSourceDocTmpl string
+
+// skeletonAttribute is an attribute or block entry in a skeleton doc.
+type skeletonAttribute struct {
+	Name     string
+	IsBlock  bool
+	Required bool
+	Optional bool
+}
+
+// skeletonBlock is a nested block section of a skeleton doc.
+type skeletonBlock struct {
+	Name       string
+	Attributes []skeletonAttribute
+}
+
+// skeletonData is the template data for a skeleton doc.
+type skeletonData struct {
+	Subcategory     string
+	TypeName        string
+	HumanName       string
+	ExampleWidth    int // Width of the longest required attribute name, for aligning the example.
+	Required        []skeletonAttribute
+	Optional        []skeletonAttribute
+	ReadOnly        []skeletonAttribute
+	Blocks          []skeletonBlock
+	HasTimeouts     bool
+	TimeoutsActions []string
+}
+
+// writeSkeletonDoc writes a skeleton documentation page for a resource or data source, listing every attribute and block.
+func writeSkeletonDoc(filename, typeName string, isDataSource bool, elements []element) error {
+	data := skeletonData{
+		Subcategory: siblingSubcategory(filepath.Dir(filename), typeName),
+		TypeName:    typeName,
+		HumanName:   humanName(typeName),
+	}
+
+	blocks := make(map[string]*skeletonBlock)
+	var blockPaths []string
+
+	for _, e := range elements {
+		if e.path == "id" {
+			continue
+		}
+
+		if e.path == "timeouts" {
+			data.HasTimeouts = true
+			continue
+		}
+
+		if strings.HasPrefix(e.path, "timeouts.") {
+			data.TimeoutsActions = append(data.TimeoutsActions, e.name())
+			continue
+		}
+
+		if e.deprecated {
+			continue
+		}
+
+		if e.isBlock {
+			blocks[e.path] = &skeletonBlock{Name: e.name()}
+			blockPaths = append(blockPaths, e.path)
+		}
+
+		attribute := skeletonAttribute{
+			Name:     e.name(),
+			IsBlock:  e.isBlock,
+			Required: e.required,
+			Optional: e.optional,
+		}
+
+		if parent := e.parent(); parent != "" {
+			if b, ok := blocks[parent]; ok {
+				b.Attributes = append(b.Attributes, attribute)
+			}
+
+			continue
+		}
+
+		switch {
+		case e.required:
+			data.Required = append(data.Required, attribute)
+
+			if !e.isBlock && len(attribute.Name) > data.ExampleWidth {
+				data.ExampleWidth = len(attribute.Name)
+			}
+		case e.optional:
+			data.Optional = append(data.Optional, attribute)
+		default:
+			data.ReadOnly = append(data.ReadOnly, attribute)
+		}
+	}
+
+	sort.Strings(blockPaths)
+	for _, path := range blockPaths {
+		data.Blocks = append(data.Blocks, *blocks[path])
+	}
+
+	tmpl := resourceDocTmpl
+	if isDataSource {
+		tmpl = dataSourceDocTmpl
+	}
+
+	t, err := template.New("doc").Parse(tmpl)
+
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+
+	if err := t.Execute(&buffer, data); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644) //nolint:gosec // Documentation is world-readable.
+}
+
+var subcategoryRegexp = regexp.MustCompile(`^subcategory:\s*"(.*)"`)
+
+// siblingSubcategory returns the subcategory of another documentation page in the same directory for the same service,
+// e.g. "IAM Access Analyzer" for aws_accessanalyzer_archive_rule, or "" if there is none.
+func siblingSubcategory(dir, typeName string) string {
+	service := strings.SplitN(strings.TrimPrefix(typeName, "aws_"), "_", 2)[0]
+	matches, _ := filepath.Glob(filepath.Join(dir, service+"_*"))
+
+	for _, match := range matches {
+		file, err := os.Open(match)
+
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			if m := subcategoryRegexp.FindStringSubmatch(scanner.Text()); m != nil {
+				file.Close()
+
+				return m[1]
+			}
+		}
+
+		file.Close()
+	}
+
+	return ""
+}
+
+// humanName returns a human-readable name for a type or attribute name, e.g. "Archive Rule" for "archive_rule".
+func humanName(name string) string {
+	parts := strings.Split(strings.TrimPrefix(name, "aws_"), "_")
+
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
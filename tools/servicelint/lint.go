@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	checkClient  = "client"
+	checkCSV     = "csv"
+	checkDocs    = "docs"
+	checkPackage = "package"
+	checkPrefix  = "prefix"
+	checkTests   = "tests"
+
+	kindDataSource     = "data source"
+	kindResource       = "resource"
+	kindServicePackage = "service package"
+)
+
+// finding is an inconsistency between names_data.csv and the provider.
+type finding struct {
+	Check   string `json:"check"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Package string `json:"package,omitempty"`
+	Message string `json:"message"`
+}
+
+func (f finding) String() string {
+	return fmt.Sprintf("%s %s: %s", f.Kind, f.Name, f.Message)
+}
+
+// registration is a resource or data source registered with the provider.
+type registration struct {
+	Kind     string
+	Package  string // Go package implementing the resource or data source, e.g. ec2.
+	TypeName string
+}
+
+type linter struct {
+	Checked     int
+	DocsDir     string
+	Findings    []finding
+	Ignore      map[string]bool
+	Services    []*service
+	ServicesDir string
+
+	testSources map[string]string // Concatenated _test.go files, by package.
+}
+
+func (l *linter) report(check, kind, name, pkg, format string, a ...any) {
+	l.Findings = append(l.Findings, finding{
+		Check:   check,
+		Kind:    kind,
+		Name:    name,
+		Package: pkg,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// lintServicePackages checks that each service package has a names_data.csv row and,
+// unless the row is excluded, an AWSClient field, e.g. ACMConn.
+func (l *linter) lintServicePackages(packages []string, clientFields map[string]bool) {
+	for _, pkg := range packages {
+		if l.Ignore[pkg] {
+			continue
+		}
+
+		l.Checked++
+
+		var implemented, provided []*service
+
+		for _, s := range l.Services {
+			if s.GoPackage == pkg {
+				implemented = append(implemented, s)
+			}
+
+			if s.ProviderPackage == pkg {
+				provided = append(provided, s)
+			}
+		}
+
+		if len(implemented) == 0 && len(provided) == 0 {
+			l.report(checkCSV, kindServicePackage, pkg, pkg, "no names_data.csv row has ProviderPackageActual, ProviderPackageCorrect or SplitPackageRealPackage %s", pkg)
+
+			continue
+		}
+
+		for _, s := range provided {
+			if s.Excluded {
+				continue
+			}
+
+			if field := s.ProviderNameUpper + "Conn"; !clientFields[field] {
+				l.report(checkClient, kindServicePackage, pkg, pkg, "AWSClient has no %s field for %s", field, s.HumanFriendly)
+			}
+		}
+	}
+}
+
+// lintRegistrations checks each resource and data source type name against the resource prefixes in names_data.csv,
+// and that it is documented and tested.
+func (l *linter) lintRegistrations(registrations []registration) {
+	sort.Slice(registrations, func(i, j int) bool {
+		if registrations[i].Kind != registrations[j].Kind {
+			return registrations[i].Kind > registrations[j].Kind // Resources first.
+		}
+
+		return registrations[i].TypeName < registrations[j].TypeName
+	})
+
+	for _, r := range registrations {
+		if l.Ignore[r.TypeName] {
+			continue
+		}
+
+		l.Checked++
+
+		l.lintPrefix(r)
+		l.lintDocs(r)
+		l.lintTests(r)
+	}
+}
+
+func (l *linter) lintPrefix(r registration) {
+	var implementing []*service
+
+	for _, s := range l.Services {
+		if s.GoPackage == r.Package {
+			implementing = append(implementing, s)
+		}
+	}
+
+	if len(implementing) == 0 {
+		l.report(checkPackage, r.Kind, r.TypeName, r.Package, "implemented in %s, which is not a service package in names_data.csv", r.Package)
+
+		return
+	}
+
+	matches := owners(l.Services, r.TypeName)
+
+	if len(matches) == 0 {
+		l.report(checkPrefix, r.Kind, r.TypeName, r.Package, "does not match the resource prefix of any service, expected %s", prefixes(implementing))
+
+		return
+	}
+
+	for _, s := range matches {
+		if s.GoPackage == r.Package {
+			return
+		}
+	}
+
+	l.report(checkPackage, r.Kind, r.TypeName, r.Package, "implemented in %s, but matches the resource prefix of %s (%s)", r.Package, matches[0].HumanFriendly, matches[0].GoPackage)
+}
+
+func (l *linter) lintDocs(r registration) {
+	subdir := "r"
+
+	if r.Kind == kindDataSource {
+		subdir = "d"
+	}
+
+	// Pages are named either <name>.html.markdown or <name>.markdown.
+	filename := filepath.Join(l.DocsDir, subdir, strings.TrimPrefix(r.TypeName, "aws_"))
+
+	for _, ext := range []string{".html.markdown", ".markdown"} {
+		if _, err := os.Stat(filename + ext); err == nil {
+			return
+		}
+	}
+
+	l.report(checkDocs, r.Kind, r.TypeName, r.Package, "%s.html.markdown not found", filename)
+}
+
+func (l *linter) lintTests(r registration) {
+	// Resources and data sources outside a service package are reported by lintPrefix.
+	if strings.Contains(r.Package, "/") {
+		return
+	}
+
+	source, err := l.testSource(r.Package)
+
+	if err != nil {
+		l.report(checkTests, r.Kind, r.TypeName, r.Package, "reading tests: %s", err)
+
+		return
+	}
+
+	if !regexp.MustCompile(`\b` + regexp.QuoteMeta(r.TypeName) + `\b`).MatchString(source) {
+		l.report(checkTests, r.Kind, r.TypeName, r.Package, "not referenced by any test in %s", filepath.Join(l.ServicesDir, r.Package))
+	}
+}
+
+// testSource returns the concatenated source of a service package's _test.go files.
+func (l *linter) testSource(pkg string) (string, error) {
+	if v, ok := l.testSources[pkg]; ok {
+		return v, nil
+	}
+
+	filenames, err := filepath.Glob(filepath.Join(l.ServicesDir, pkg, "*_test.go"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	for _, filename := range filenames {
+		v, err := os.ReadFile(filename)
+
+		if err != nil {
+			return "", err
+		}
+
+		b.Write(v)
+	}
+
+	if l.testSources == nil {
+		l.testSources = make(map[string]string)
+	}
+
+	l.testSources[pkg] = b.String()
+
+	return l.testSources[pkg], nil
+}
+
+func prefixes(services []*service) string {
+	var v []string
+
+	for _, s := range services {
+		if s.prefix != nil {
+			v = append(v, strings.TrimSuffix(strings.TrimPrefix(s.prefix.String(), "^(?:"), ")"))
+		}
+	}
+
+	if len(v) == 0 {
+		return "no prefix"
+	}
+
+	return strings.Join(v, " or ")
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testNamesData = `AWSCLIV2Command,AWSCLIV2CommandNoDashes,GoV1Package,GoV2Package,ProviderPackageActual,ProviderPackageCorrect,SplitPackageRealPackage,Aliases,ProviderNameUpper,GoV1ClientTypeName,SkipClientGenerate,SDKVersion,ResourcePrefixActual,ResourcePrefixCorrect,FilePrefix,DocPrefix,HumanFriendly,Brand,Exclude,AllowedSubcategory,DeprecatedEnvVar,EnvVar,Note
+acm,acm,acm,acm,,acm,,,ACM,ACM,,1,,aws_acm_,,acm_,ACM (Certificate Manager),AWS,,,,,
+cloudwatch,cloudwatch,cloudwatch,cloudwatch,,cloudwatch,,,CloudWatch,CloudWatch,,1,aws_cloudwatch_(?!(event_|log_|query_)),aws_cloudwatch_,,cloudwatch_dashboard,CloudWatch,Amazon,,,,,
+logs,logs,cloudwatchlogs,cloudwatchlogs,,logs,,,Logs,CloudWatchLogs,,1,aws_cloudwatch_(log_|query_),aws_logs_,,cloudwatch_log_,CloudWatch Logs,Amazon,,,,,
+ec2,ec2,ec2,ec2,,ec2,ec2,,EC2,EC2,,1,aws_(ami|instance),aws_ec2_,ec2_,ami;instance,EC2 (Elastic Compute Cloud),Amazon,,,,,
+,,,,,vpc,ec2,,VPC,,,,aws_((default_)?(subnet|vpc(?!_ipam))),aws_vpc_,vpc_,subnet;vpc,VPC (Virtual Private Cloud),Amazon,x,,,,Part of EC2
+,,,,,ipam,ec2,,IPAM,,,,aws_vpc_ipam,aws_ipam_,ipam_,vpc_ipam,IPAM (IP Address Manager),Amazon,x,,,,Part of EC2
+,,,,,meta,,,Meta,,,,aws_(arn|partition|regions?)$,aws_meta_,,arn;partition;region,Meta Data Sources,,x,,,,Not an AWS service
+,,,,,,,,Unused,,,,,,,,Unused,,x,,,,No package
+`
+
+func testServices(t *testing.T) []*service {
+	t.Helper()
+
+	services, err := readServices(strings.NewReader(testNamesData))
+
+	if err != nil {
+		t.Fatalf("reading services: %s", err)
+	}
+
+	return services
+}
+
+func TestReadServices(t *testing.T) {
+	services := testServices(t)
+
+	if got, expected := len(services), 7; got != expected {
+		t.Fatalf("got %d services, expected %d", got, expected)
+	}
+
+	if got, expected := services[4].GoPackage, "ec2"; got != expected {
+		t.Errorf("got Go package %q, expected %q", got, expected)
+	}
+
+	if got, expected := services[4].ProviderPackage, "vpc"; got != expected {
+		t.Errorf("got provider package %q, expected %q", got, expected)
+	}
+
+	if !services[4].Excluded {
+		t.Error("expected service to be excluded")
+	}
+}
+
+func TestStripNegativeLookaheads(t *testing.T) {
+	testCases := map[string]string{
+		"aws_acm_": "aws_acm_",
+		"aws_cloudwatch_(?!(event_|log_|query_))":   "aws_cloudwatch_",
+		"aws_((default_)?(subnet|vpc(?!_ipam)))":    "aws_((default_)?(subnet|vpc))",
+		"aws_a(?!b)_(?!c)d":                         "aws_a_d",
+		"aws_cognito_identity_(?!provider":          "aws_cognito_identity_",
+		"aws_(arn|billing_service_account|region)$": "aws_(arn|billing_service_account|region)$",
+	}
+
+	for input, expected := range testCases {
+		if got := stripNegativeLookaheads(input); got != expected {
+			t.Errorf("stripNegativeLookaheads(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestOwners(t *testing.T) {
+	services := testServices(t)
+
+	testCases := map[string][]string{
+		"aws_acm_certificate":           {"ACM (Certificate Manager)"},
+		"aws_cloudwatch_dashboard":      {"CloudWatch"},
+		"aws_cloudwatch_log_group":      {"CloudWatch Logs"},
+		"aws_instance":                  {"EC2 (Elastic Compute Cloud)"},
+		"aws_default_subnet":            {"VPC (Virtual Private Cloud)"},
+		"aws_vpc_endpoint":              {"VPC (Virtual Private Cloud)"},
+		"aws_vpc_ipam_pool":             {"IPAM (IP Address Manager)"},
+		"aws_region":                    {"Meta Data Sources"},
+		"aws_regions":                   {"Meta Data Sources"},
+		"aws_region_other":              nil,
+		"aws_s3_bucket":                 nil,
+		"aws_cloudwatch_query_defn_ext": {"CloudWatch Logs"},
+	}
+
+	for typeName, expected := range testCases {
+		var got []string
+
+		for _, s := range owners(services, typeName) {
+			got = append(got, s.HumanFriendly)
+		}
+
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("owners(%q) = %v, expected %v", typeName, got, expected)
+		}
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	testCases := map[string]string{
+		"github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceVPCRead":   "ec2",
+		"github.com/hashicorp/terraform-provider-aws/internal/service/ec2.ResourceVPC.func1": "ec2",
+		"github.com/hashicorp/terraform-provider-aws/internal/service/meta":                  "meta",
+		"github.com/hashicorp/terraform-provider-aws/internal/provider.resourceRead":         "github.com/hashicorp/terraform-provider-aws/internal/provider",
+	}
+
+	for input, expected := range testCases {
+		if got := packageName(input); got != expected {
+			t.Errorf("packageName(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestLinter(t *testing.T) {
+	dir := t.TempDir()
+	docsDir := filepath.Join(dir, "docs")
+	servicesDir := filepath.Join(dir, "service")
+
+	writeTestFile(t, filepath.Join(docsDir, "r", "acm_certificate.html.markdown"), "")
+	writeTestFile(t, filepath.Join(docsDir, "r", "instance.html.markdown"), "")
+	writeTestFile(t, filepath.Join(docsDir, "r", "subnet.markdown"), "") // Some pages are named without .html.
+	writeTestFile(t, filepath.Join(docsDir, "d", "region.html.markdown"), "")
+	writeTestFile(t, filepath.Join(servicesDir, "acm", "certificate_test.go"), `resourceName := "aws_acm_certificate.test"`)
+	writeTestFile(t, filepath.Join(servicesDir, "ec2", "instance_test.go"), `resource "aws_instance" "test" {}`)
+	writeTestFile(t, filepath.Join(servicesDir, "ec2", "vpc_test.go"), `resource "aws_subnet_other" "test" {}`)
+	writeTestFile(t, filepath.Join(servicesDir, "meta", "region_data_source_test.go"), `data "aws_region" "test" {}`)
+	writeTestFile(t, filepath.Join(servicesDir, "s3", "bucket.go"), "")
+
+	l := &linter{
+		DocsDir:     docsDir,
+		Ignore:      map[string]bool{"aws_ignored": true},
+		Services:    testServices(t),
+		ServicesDir: servicesDir,
+	}
+
+	l.lintServicePackages([]string{"acm", "cloudwatch", "ec2", "meta", "s3"}, map[string]bool{"ACMConn": true, "EC2Conn": true})
+	l.lintRegistrations([]registration{
+		{Kind: kindDataSource, Package: "meta", TypeName: "aws_region"},
+		{Kind: kindResource, Package: "acm", TypeName: "aws_acm_certificate"},
+		{Kind: kindResource, Package: "ec2", TypeName: "aws_instance"},
+		{Kind: kindResource, Package: "ec2", TypeName: "aws_subnet"},
+		{Kind: kindResource, Package: "acm", TypeName: "aws_cloudwatch_dashboard"},
+		{Kind: kindResource, Package: "ec2", TypeName: "aws_ec2_thing"},
+		{Kind: kindResource, Package: "s3", TypeName: "aws_s3_bucket"},
+		{Kind: kindResource, Package: "acm", TypeName: "aws_ignored"},
+	})
+
+	var got []string
+
+	for _, f := range l.Findings {
+		got = append(got, f.Check+" "+f.Name)
+	}
+
+	expected := []string{
+		"client cloudwatch",
+		"csv s3",
+		"package aws_cloudwatch_dashboard",
+		"docs aws_cloudwatch_dashboard",
+		"tests aws_cloudwatch_dashboard",
+		"prefix aws_ec2_thing",
+		"docs aws_ec2_thing",
+		"tests aws_ec2_thing",
+		"package aws_s3_bucket",
+		"docs aws_s3_bucket",
+		"tests aws_s3_bucket",
+		"tests aws_subnet",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got findings\n%s\nexpected\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+
+	if got, expected := l.Checked, 12; got != expected {
+		t.Errorf("got %d checked, expected %d", got, expected)
+	}
+}
+
+func writeTestFile(t *testing.T, filename, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
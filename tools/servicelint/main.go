@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+var (
+	docsDir     = flag.String("docs", "website/docs", "Path of the website documentation directory")
+	format      = flag.String("format", "text", "Output format: text or json")
+	ignore      = flag.String("ignore", "", "Comma-separated list of resource and data source types and service packages not to check")
+	namesData   = flag.String("names", "names/names_data.csv", "Path of names_data.csv")
+	servicesDir = flag.String("services", "internal/service", "Path of the service packages directory")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tservicelint [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 0 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ui := &cli.BasicUi{
+		Reader:      os.Stdin,
+		Writer:      os.Stdout,
+		ErrorWriter: os.Stderr,
+	}
+	linter := &linter{
+		DocsDir:     *docsDir,
+		Ignore:      make(map[string]bool),
+		ServicesDir: *servicesDir,
+	}
+
+	for _, v := range strings.Split(*ignore, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			linter.Ignore[v] = true
+		}
+	}
+
+	if err := run(context.Background(), linter); err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+
+	if *format == "json" {
+		if err := writeJSON(linter); err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+	} else {
+		for _, f := range linter.Findings {
+			ui.Warn(f.String())
+		}
+	}
+
+	if n := len(linter.Findings); n > 0 {
+		ui.Error(fmt.Sprintf("%d findings in %d service packages, resources and data sources checked", n, linter.Checked))
+		os.Exit(1)
+	}
+
+	if *format == "text" {
+		ui.Info(fmt.Sprintf("%d service packages, resources and data sources checked", linter.Checked))
+	}
+}
+
+func run(ctx context.Context, linter *linter) error {
+	file, err := os.Open(*namesData)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	linter.Services, err = readServices(file)
+
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", *namesData, err)
+	}
+
+	packages, err := servicePackages(linter.ServicesDir)
+
+	if err != nil {
+		return err
+	}
+
+	registrations, err := providerRegistrations(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	linter.lintServicePackages(packages, clientFields())
+	linter.lintRegistrations(registrations)
+
+	return nil
+}
+
+func writeJSON(linter *linter) error {
+	findings := linter.Findings
+
+	if findings == nil {
+		findings = []finding{}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(struct {
+		Checked  int       `json:"checked"`
+		Findings []finding `json:"findings"`
+	}{
+		Checked:  linter.Checked,
+		Findings: findings,
+	})
+}
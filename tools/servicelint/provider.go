@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	providerModulePath = "github.com/hashicorp/terraform-provider-aws/"
+	servicePackagePath = providerModulePath + "internal/service/"
+)
+
+// providerRegistrations returns the resources and data sources registered in provider.Provider() (Plugin SDK)
+// and in each service package's ServicePackageData (Plugin Framework).
+func providerRegistrations(ctx context.Context) ([]registration, error) {
+	p, err := provider.New(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var registrations []registration
+
+	for typeName, r := range p.ResourcesMap {
+		registrations = append(registrations, registration{
+			Kind:     kindResource,
+			Package:  sdkResourcePackage(r),
+			TypeName: typeName,
+		})
+	}
+
+	for typeName, r := range p.DataSourcesMap {
+		registrations = append(registrations, registration{
+			Kind:     kindDataSource,
+			Package:  sdkResourcePackage(r),
+			TypeName: typeName,
+		})
+	}
+
+	for _, sp := range p.Meta().(*conns.AWSClient).ServicePackages {
+		for _, factory := range sp.FrameworkResources(ctx) {
+			r, err := factory(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("creating %s resource: %w", sp.ServicePackageName(), err)
+			}
+
+			var response resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			registrations = append(registrations, registration{
+				Kind:     kindResource,
+				Package:  typePackage(r),
+				TypeName: response.TypeName,
+			})
+		}
+
+		for _, factory := range sp.FrameworkDataSources(ctx) {
+			d, err := factory(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("creating %s data source: %w", sp.ServicePackageName(), err)
+			}
+
+			var response datasource.MetadataResponse
+			d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			registrations = append(registrations, registration{
+				Kind:     kindDataSource,
+				Package:  typePackage(d),
+				TypeName: response.TypeName,
+			})
+		}
+	}
+
+	return registrations, nil
+}
+
+// sdkResourcePackage returns the package implementing a Plugin SDK resource or data source,
+// which is that of its Read (or, failing that, Create) function.
+// Functions outside the provider, e.g. schema.Noop, are skipped if possible.
+func sdkResourcePackage(r *schema.Resource) string {
+	var pkg string
+
+	for _, f := range []any{r.ReadWithoutTimeout, r.ReadContext, r.Read, r.CreateWithoutTimeout, r.CreateContext, r.Create} { // nolint:staticcheck
+		v := reflect.ValueOf(f)
+
+		if v.IsNil() {
+			continue
+		}
+
+		fn := runtime.FuncForPC(v.Pointer())
+
+		if fn == nil {
+			continue
+		}
+
+		if strings.HasPrefix(fn.Name(), providerModulePath) {
+			return packageName(fn.Name())
+		}
+
+		if pkg == "" {
+			pkg = packageName(fn.Name())
+		}
+	}
+
+	return pkg
+}
+
+// packageName returns the short name of a service package, e.g. ec2, from a qualified
+// function name (github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceVPCRead)
+// or package path. Other packages' paths are returned unchanged.
+func packageName(name string) string {
+	path := name
+
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		if j := strings.Index(name[i:], "."); j >= 0 {
+			path = name[:i+j]
+		}
+	}
+
+	if v := strings.TrimPrefix(path, servicePackagePath); v != path {
+		return v
+	}
+
+	return path
+}
+
+func typePackage(v any) string {
+	t := reflect.TypeOf(v)
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return packageName(t.PkgPath())
+}
+
+// clientFields returns the names of AWSClient's fields.
+func clientFields() map[string]bool {
+	t := reflect.TypeOf(conns.AWSClient{})
+	fields := make(map[string]bool, t.NumField())
+
+	for i := 0; i < t.NumField(); i++ {
+		fields[t.Field(i).Name] = true
+	}
+
+	return fields
+}
+
+// servicePackages returns the names of the directories under internal/service.
+func servicePackages(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []string
+
+	for _, e := range entries {
+		if e.IsDir() {
+			packages = append(packages, e.Name())
+		}
+	}
+
+	return packages, nil
+}
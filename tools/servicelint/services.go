@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// service is a row of names/names_data.csv.
+type service struct {
+	Excluded          bool
+	GoPackage         string // Go package implementing the service, e.g. ec2 for the vpc split package.
+	HumanFriendly     string
+	ProviderNameUpper string
+	ProviderPackage   string // ProviderPackageActual, or ProviderPackageCorrect if that is blank.
+
+	prefix *regexp.Regexp // Resource and data source type names, e.g. ^(?:aws_acm_).
+}
+
+// readServices reads the names_data.csv rows that name a provider package.
+func readServices(r io.Reader) ([]*service, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var services []*service
+
+	for i, l := range rows {
+		if i < 1 { // no header
+			continue
+		}
+
+		s := &service{
+			Excluded:          l[names.ColExclude] != "",
+			HumanFriendly:     l[names.ColHumanFriendly],
+			ProviderNameUpper: l[names.ColProviderNameUpper],
+			ProviderPackage:   l[names.ColProviderPackageCorrect],
+		}
+
+		if v := l[names.ColProviderPackageActual]; v != "" {
+			s.ProviderPackage = v
+		}
+
+		if s.ProviderPackage == "" {
+			continue
+		}
+
+		s.GoPackage = s.ProviderPackage
+
+		if v := l[names.ColSplitPackageRealPackage]; v != "" {
+			s.GoPackage = v
+		}
+
+		prefix := l[names.ColResourcePrefixCorrect]
+
+		if v := l[names.ColResourcePrefixActual]; v != "" {
+			prefix = v
+		}
+
+		if prefix != "" {
+			re, err := compilePrefix(prefix)
+
+			if err != nil {
+				return nil, fmt.Errorf("service %s: %w", s.HumanFriendly, err)
+			}
+
+			s.prefix = re
+		}
+
+		services = append(services, s)
+	}
+
+	return services, nil
+}
+
+// compilePrefix compiles a ResourcePrefixActual or ResourcePrefixCorrect value.
+// Go regular expressions do not support negative lookahead, e.g. aws_cloudwatch_(?!(event_|log_|query_)).
+// It is removed; the longer prefix of the service the lookahead excludes (e.g. aws_cloudwatch_(log_|query_)) wins instead.
+func compilePrefix(prefix string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + stripNegativeLookaheads(prefix) + ")")
+
+	if err != nil {
+		return nil, fmt.Errorf("compiling resource prefix %q: %w", prefix, err)
+	}
+
+	re.Longest()
+
+	return re, nil
+}
+
+func stripNegativeLookaheads(s string) string {
+	var b strings.Builder
+
+	for {
+		i := strings.Index(s, "(?!")
+
+		if i < 0 {
+			b.WriteString(s)
+
+			return b.String()
+		}
+
+		b.WriteString(s[:i])
+
+		depth := 0
+		j := i
+
+		for ; j < len(s); j++ {
+			if s[j] == '(' {
+				depth++
+			} else if s[j] == ')' {
+				depth--
+
+				if depth == 0 {
+					break
+				}
+			}
+		}
+
+		s = s[min(j+1, len(s)):]
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// owners returns the services whose resource prefix is the longest match for the type name.
+func owners(services []*service, typeName string) []*service {
+	var matches []*service
+	longest := 0
+
+	for _, s := range services {
+		if s.prefix == nil {
+			continue
+		}
+
+		loc := s.prefix.FindStringIndex(typeName)
+
+		if loc == nil {
+			continue
+		}
+
+		switch n := loc[1]; {
+		case n > longest:
+			longest = n
+			matches = []*service{s}
+		case n == longest:
+			matches = append(matches, s)
+		}
+	}
+
+	return matches
+}
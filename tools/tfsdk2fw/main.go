@@ -10,9 +10,12 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
@@ -28,6 +31,7 @@ var (
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
 	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "A schema equivalence test is generated alongside <generated-file>, e.g. thing.go -> thing_schema_test.go.\n\n")
 }
 
 func main() {
@@ -74,6 +78,7 @@ func main() {
 		migrator.IsDataSource = true
 		migrator.Resource = resource
 		migrator.Template = datasourceImpl
+		migrator.TestTemplate = datasourceTestImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
 		resource, ok := p.ResourcesMap[v]
@@ -85,6 +90,7 @@ func main() {
 
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
+		migrator.TestTemplate = resourceTestImpl
 		migrator.TFTypeName = v
 	}
 
@@ -100,11 +106,49 @@ type migrator struct {
 	PackageName  string
 	Resource     *schema.Resource
 	Template     string
+	TestTemplate string
 	TFTypeName   string
 	Ui           cli.Ui
 }
 
-// migrate generates an identical schema into the specified output file.
+// sdkFactoryName returns the name of the function that returns the Plugin SDK resource or data source,
+// e.g. ResourceInstance. The name is derived from that of the resource's Read function.
+func (m *migrator) sdkFactoryName() string {
+	prefix := "Resource"
+	if m.IsDataSource {
+		prefix = "DataSource"
+	}
+
+	var f interface{}
+	switch r := m.Resource; {
+	case r.Read != nil:
+		f = r.Read
+	case r.ReadContext != nil:
+		f = r.ReadContext
+	case r.ReadWithoutTimeout != nil:
+		f = r.ReadWithoutTimeout
+	}
+
+	if f != nil {
+		// e.g. github.com/hashicorp/terraform-provider-aws/internal/service/ec2.resourceInstanceRead
+		funcName := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+
+		if parts := strings.Split(path.Base(funcName), "."); len(parts) == 2 && parts[0] == m.PackageName {
+			name := strings.TrimSuffix(parts[1], "Read")
+
+			if v := strings.ToLower(prefix[:1]) + prefix[1:]; strings.HasPrefix(name, v) && name != parts[1] {
+				return prefix + strings.TrimPrefix(name, v)
+			}
+		}
+	}
+
+	m.warnf("Plugin SDK %s function name cannot be determined, using %s%s", strings.ToLower(prefix), prefix, m.Name)
+
+	return prefix + m.Name
+}
+
+// migrate generates an identical schema into the specified output file
+// and a schema equivalence test into a test file alongside it.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -122,11 +166,19 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
-	return m.applyTemplate(outputFilename, templateData)
+	if err := m.applyTemplate(outputFilename, m.Template, templateData); err != nil {
+		return err
+	}
+
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_schema_test.go"
+
+	m.infof("generating schema equivalence test into %[1]q", testFilename)
+
+	return m.applyTemplate(testFilename, m.TestTemplate, templateData)
 }
 
-func (m *migrator) applyTemplate(filename string, templateData *templateData) error {
-	tmpl, err := template.New("schema").Parse(m.Template)
+func (m *migrator) applyTemplate(filename, templateBody string, templateData *templateData) error {
+	tmpl, err := template.New("schema").Parse(templateBody)
 
 	if err != nil {
 		return fmt.Errorf("parsing schema template: %w", err)
@@ -164,10 +216,17 @@ func (m *migrator) applyTemplate(filename string, templateData *templateData) er
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	sbFlex := strings.Builder{}
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	prefix := "resource"
+	if m.IsDataSource {
+		prefix = "dataSource"
+	}
 	emitter := &emitter{
+		FlexWriter:   &sbFlex,
 		IsDataSource: m.IsDataSource,
+		Prefix:       prefix + m.Name,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 		Ui:           m.Ui,
@@ -179,32 +238,128 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	sbStateUpgraders := strings.Builder{}
+
+	if !m.IsDataSource {
+		m.emitStateUpgraders(&sbStateUpgraders)
+	}
+
 	templateData := &templateData{
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		EmitResourceImportState:              m.Resource.Importer != nil,
+		EmitResourceUpdateSkeleton:           m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
+		Flex:                                 sbFlex.String(),
+		ImportFrameworkAttr:                  emitter.ImportFrameworkAttr,
+		ImportProviderFrameworkPlanModifiers: emitter.ImportProviderFrameworkPlanModifiers,
+		ImportProviderFrameworkTypes:         emitter.ImportProviderFrameworkTypes,
+		ImportTime:                           emitter.ImportTime,
+		Name:                                 m.Name,
+		PackageName:                          m.PackageName,
+		SDKFactoryName:                       m.sdkFactoryName(),
+		Schema:                               sbSchema.String(),
+		StateUpgraders:                       sbStateUpgraders.String(),
+		Struct:                               sbStruct.String(),
+		TFTypeName:                           m.TFTypeName,
 	}
 
 	return templateData, nil
 }
 
+// emitStateUpgraders generates a Plugin Framework state upgrader for each prior Plugin SDK schema version
+// and emits the generated code to the specified Writer.
+// Each upgrader runs the Plugin SDK state upgrade functions from its prior version to the current version in order
+// so that state written by the Plugin SDK resource can be read by the Plugin Framework resource.
+func (m *migrator) emitStateUpgraders(w io.Writer) {
+	if m.Resource.MigrateState != nil {
+		m.warnf("MigrateState is not supported: flatmap state must be upgraded manually")
+	}
+
+	upgraders := make([]schema.StateUpgrader, len(m.Resource.StateUpgraders))
+	copy(upgraders, m.Resource.StateUpgraders)
+	sort.SliceStable(upgraders, func(i, j int) bool {
+		return upgraders[i].Version < upgraders[j].Version
+	})
+
+	for version := 0; version < m.Resource.SchemaVersion; version++ {
+		var funcs []schema.StateUpgradeFunc
+
+		for _, upgrader := range upgraders {
+			if upgrader.Version >= version {
+				funcs = append(funcs, upgrader.Upgrade)
+			}
+		}
+
+		if len(funcs) == 0 || upgraders[len(upgraders)-len(funcs)].Version != version {
+			m.warnf("No state upgrader from schema version %d", version)
+			continue
+		}
+
+		fprintf(w, "%d: {\n", version)
+		fprintf(w, "StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {\n")
+		fprintf(w, "fwmigrate.UpgradeSDKState(ctx, r.meta, request, response,\n")
+		for _, f := range funcs {
+			m.emitStateUpgradeFunc(w, f)
+		}
+		fprintf(w, ")\n")
+		fprintf(w, "},\n")
+		fprintf(w, "},\n")
+	}
+}
+
+// emitStateUpgradeFunc emits a reference to a Plugin SDK state upgrade function.
+// Functions that cannot be referenced from the generated code are replaced by a stub that fails the upgrade.
+func (m *migrator) emitStateUpgradeFunc(w io.Writer, f schema.StateUpgradeFunc) {
+	// e.g. github.com/hashicorp/terraform-provider-aws/internal/service/ec2.instanceStateUpgradeV0
+	funcName := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+
+	if parts := strings.Split(path.Base(funcName), "."); len(parts) == 2 && parts[0] == m.PackageName {
+		fprintf(w, "%s,\n", parts[1])
+
+		return
+	}
+
+	m.warnf("State upgrade function %s must be ported manually", funcName)
+
+	fprintf(w, "// TODO Port %s.\n", funcName)
+	fprintf(w, "fwmigrate.UpgradeNotImplemented(%q),\n", funcName)
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Ui.Info(fmt.Sprintf(format, a...))
 }
 
+func (m *migrator) warnf(format string, a ...interface{}) {
+	m.Ui.Warn(fmt.Sprintf(format, a...))
+}
+
 type emitter struct {
-	ImportFrameworkAttr          bool
-	ImportProviderFrameworkTypes bool
-	IsDataSource                 bool
-	SchemaWriter                 io.Writer
-	StructWriter                 io.Writer
-	Ui                           cli.Ui
+	FlexWriter                           io.Writer
+	ImportFrameworkAttr                  bool
+	ImportProviderFrameworkPlanModifiers bool
+	ImportProviderFrameworkTypes         bool
+	ImportTime                           bool
+	IsDataSource                         bool
+	Prefix                               string // e.g. resourceInstance
+	SchemaWriter                         io.Writer
+	StructWriter                         io.Writer
+	Ui                                   cli.Ui
+
+	// flex holds the generated model, expand and flatten code for each nested block, in schema order.
+	flex []string
+}
+
+// model is the generated model code for a Plugin SDK Resource or nested Block.
+type model struct {
+	attrTypes    strings.Builder // Entries of the object's map[string]attr.Type.
+	attrValues   strings.Builder // Entries of the object's map[string]attr.Value, each a placeholder value.
+	fields       strings.Builder // Fields of the model struct.
+	nestedBlocks []string        // Names of nested blocks.
+}
+
+// fwType is the Plugin Framework type of a Plugin SDK Attribute.
+type fwType struct {
+	attrType  string // e.g. types.StringType
+	valueType string // e.g. types.String
+	nullValue string // e.g. types.String{Null:true}
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -221,12 +376,14 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "tfsdk.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	m, err := e.emitAttributesAndBlocks(nil, resource.Schema, resource.Timeouts)
 
 	if err != nil {
 		return err
 	}
 
+	fprintf(e.StructWriter, "%s", m.fields.String())
+
 	if version := resource.SchemaVersion; version > 0 {
 		fprintf(e.SchemaWriter, "Version:%d,\n", version)
 	}
@@ -241,25 +398,30 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "}")
 
+	for _, v := range e.flex {
+		fprintf(e.FlexWriter, "%s\n", v)
+	}
+
 	return nil
 }
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
 // and emits the generated code to the emitter's Writer.
+// The generated model code is returned.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
+func (e *emitter) emitAttributesAndBlocks(path []string, properties map[string]*schema.Schema, timeouts *schema.ResourceTimeout) (*model, error) {
+	m := &model{}
 
 	// At this point we are emitting code for a tfsdk.Block or Schema.
 	names := make([]string, 0)
-	for name := range schema {
+	for name := range properties {
 		names = append(names, name)
 	}
 	sort.Strings(names)
 
 	emittedFieldName := false
 	for _, name := range names {
-		property := schema[name]
+		property := properties[name]
 
 		if !isAttribute(property) {
 			continue
@@ -272,21 +434,24 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
-		err := e.emitAttributeProperty(append(path, name), property)
+		attributePath := append(path[:len(path):len(path)], name)
+		typ, err := e.attributeType(attributePath, property, true)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+		err = e.emitAttributeProperty(attributePath, property, typ)
+
+		if err != nil {
+			return nil, err
 		}
 
 		fprintf(e.SchemaWriter, ",\n")
+
+		fprintf(&m.fields, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), typ.valueType, name)
+		fprintf(&m.attrTypes, "%q:%s,\n", name, typ.attrType)
+		fprintf(&m.attrValues, "%q:%s,\n", name, typ.nullValue)
 	}
 	if emittedFieldName {
 		fprintf(e.SchemaWriter, "},\n")
@@ -294,7 +459,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 	emittedFieldName = false
 	for _, name := range names {
-		property := schema[name]
+		property := properties[name]
 
 		if isAttribute(property) {
 			continue
@@ -307,75 +472,76 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 
 		fprintf(e.SchemaWriter, "%q:", name)
 
-		err := e.emitBlockProperty(append(path, name), property)
+		blockPath := append(path[:len(path):len(path)], name)
+		err := e.emitBlockProperty(blockPath, property)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		fprintf(e.SchemaWriter, ",\n")
+
+		valueType := "types.List"
+		if property.Type == schema.TypeSet {
+			valueType = "types.Set"
+		}
+		fprintf(&m.fields, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), valueType, name)
+		fprintf(&m.attrTypes, "%q:%sType{ElemType:types.ObjectType{AttrTypes:%sAttrTypes}},\n", name, valueType, e.blockName(blockPath))
+		fprintf(&m.attrValues, "%q:%s(ctx, nil),\n", name, e.funcName("flatten", blockPath))
+		m.nestedBlocks = append(m.nestedBlocks, name)
+	}
+
+	if timeouts != nil {
+		if _, ok := properties[schema.TimeoutsConfigKey]; ok {
+			e.warnf("Explicit `timeouts` property defined")
+		} else {
+			if !emittedFieldName {
+				fprintf(e.SchemaWriter, "Blocks: map[string]tfsdk.Block{\n")
+				emittedFieldName = true
+			}
+
+			e.emitTimeouts(timeouts)
+
+			fprintf(&m.fields, "Timeouts types.Object `tfsdk:\"timeouts\"`\n")
+		}
 	}
+
 	if emittedFieldName {
 		fprintf(e.SchemaWriter, "},\n")
 	}
 
-	return nil
+	return m, nil
 }
 
-// emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
-// and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// attributeType returns the Plugin Framework type of a Plugin SDK Attribute.
+// ARN-valued attributes use the provider's ARN type if allowARN is true.
+func (e *emitter) attributeType(path []string, property *schema.Schema, allowARN bool) (fwType, error) {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
-	var planModifiers []string
-
-	// At this point we are emitting code for the values of a tfsdk.Schema's Attributes (map[string]tfsdk.Attribute).
-	fprintf(e.SchemaWriter, "{\n")
 
 	switch v := property.Type; v {
 	//
 	// Primitive types.
 	//
 	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "Type:types.BoolType,\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		return primitiveType("types.Bool"), nil
 
 	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "Type:types.Float64Type,\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		return primitiveType("types.Float64"), nil
 
 	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "Type:types.Int64Type,\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		return primitiveType("types.Int64"), nil
 
 	case schema.TypeString:
 		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		if allowARN && (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
 			e.ImportProviderFrameworkTypes = true
 
-			fprintf(e.SchemaWriter, "Type:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
-		} else {
-			fprintf(e.SchemaWriter, "Type:types.StringType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			return primitiveType("fwtypes.ARN"), nil
 		}
 
+		return primitiveType("types.String"), nil
+
 	//
 	// Complex types.
 	//
@@ -384,29 +550,20 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		switch v {
 		case schema.TypeList:
-			aggregateType = "types.ListType"
+			aggregateType = "types.List"
 			typeName = "list"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
 		case schema.TypeMap:
-			aggregateType = "types.MapType"
+			aggregateType = "types.Map"
 			typeName = "map"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
 		case schema.TypeSet:
-			aggregateType = "types.SetType"
+			aggregateType = "types.Set"
 			typeName = "set"
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
 		}
 
+		var elementType string
+
 		switch v := property.Elem.(type) {
 		case *schema.Schema:
-			var elementType string
-
 			switch v := v.Type; v {
 			case schema.TypeBool:
 				elementType = "types.BoolType"
@@ -421,29 +578,84 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 				elementType = "types.StringType"
 
 			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
+				return fwType{}, unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
-			fprintf(e.SchemaWriter, "Type:%s{ElemType:%s},\n", aggregateType, elementType)
-
 		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "Type:%s{ElemType:", aggregateType)
+			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeAttr.
+			var err error
+			elementType, err = e.objectType(path, v.Schema)
 
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
+			if err != nil {
+				return fwType{}, err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
-
 		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			return fwType{}, unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
 		}
 
+		return fwType{
+			attrType:  fmt.Sprintf("%sType{ElemType:%s}", aggregateType, elementType),
+			valueType: aggregateType,
+			nullValue: fmt.Sprintf("%s{ElemType:%s,Null:true}", aggregateType, elementType),
+		}, nil
+
 	default:
-		return unsupportedTypeError(path, v.String())
+		return fwType{}, unsupportedTypeError(path, v.String())
+	}
+}
+
+// objectType returns the Plugin Framework object type of a Plugin SDK Computed-only nested block.
+// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
+// Property names are sorted prior to code generation to reduce diffs.
+func (e *emitter) objectType(path []string, schema map[string]*schema.Schema) (string, error) {
+	names := make([]string, 0)
+	for name := range schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+
+	fprintf(&sb, "types.ObjectType{\n")
+
+	emittedFieldName := false
+	for _, name := range names {
+		property := schema[name]
+
+		if !emittedFieldName {
+			fprintf(&sb, "AttrTypes: map[string]attr.Type{\n")
+			emittedFieldName = true
+			e.ImportFrameworkAttr = true
+		}
+
+		typ, err := e.attributeType(append(path[:len(path):len(path)], name), property, false)
+
+		if err != nil {
+			return "", err
+		}
+
+		fprintf(&sb, "%q:%s,\n", name, typ.attrType)
+	}
+	if emittedFieldName {
+		fprintf(&sb, "},\n")
 	}
 
+	fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
+// emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
+// and emits the generated code to the emitter's Writer.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema, typ fwType) error {
+	var planModifiers []string
+
+	// At this point we are emitting code for the values of a tfsdk.Schema's Attributes (map[string]tfsdk.Attribute).
+	fprintf(e.SchemaWriter, "{\n")
+
+	fprintf(e.SchemaWriter, "Type:%s,\n", typ.attrType)
+
 	if property.Required {
 		fprintf(e.SchemaWriter, "Required:true,\n")
 	}
@@ -452,7 +664,8 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "Optional:true,\n")
 	}
 
-	if property.Computed {
+	// Plugin Framework attributes with a default value must be Computed.
+	if property.Computed || property.Default != nil {
 		fprintf(e.SchemaWriter, "Computed:true,\n")
 	}
 
@@ -468,7 +681,16 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
 	}
 
-	if property.ForceNew {
+	if def := property.Default; def != nil {
+		// Data sources must set default values in Read.
+		if planModifier := e.defaultValuePlanModifier(def, typ); planModifier != "" {
+			planModifiers = append(planModifiers, planModifier)
+		} else {
+			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+		}
+	}
+
+	if property.ForceNew && !e.IsDataSource {
 		planModifiers = append(planModifiers, "resource.RequiresReplace()")
 	}
 
@@ -482,20 +704,6 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 	// Features that we can't (yet) migrate:
 
-	if def := property.Default; def != nil {
-		switch def.(type) {
-		case bool:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case int:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case float64:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		case string:
-			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
-		default:
-		}
-	}
-
 	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
 		fprintf(e.SchemaWriter, "// TODO Validate,\n")
 	}
@@ -505,50 +713,84 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	return nil
 }
 
+// defaultValuePlanModifier returns the Plugin Framework plan modifier that sets a Plugin SDK Attribute's default value.
+// An empty string is returned if the default value cannot be migrated or the attribute belongs to a data source.
+func (e *emitter) defaultValuePlanModifier(def interface{}, typ fwType) string {
+	if e.IsDataSource {
+		return ""
+	}
+
+	var planModifier string
+
+	switch v := def.(type) {
+	case bool:
+		if typ.valueType == "types.Bool" {
+			planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Bool{Value:%t})", v)
+		}
+	case int:
+		if typ.valueType == "types.Int64" {
+			planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Int64{Value:%d})", v)
+		}
+	case float64:
+		if typ.valueType == "types.Float64" {
+			planModifier = fmt.Sprintf("fwplanmodifiers.DefaultValue(types.Float64{Value:%#v})", v)
+		}
+	case string:
+		if typ.valueType == "types.String" {
+			planModifier = fmt.Sprintf("fwplanmodifiers.DefaultStringValue(%q)", v)
+		}
+	}
+
+	if planModifier != "" {
+		e.ImportProviderFrameworkPlanModifiers = true
+	}
+
+	return planModifier
+}
+
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
 // and emits the generated code to the emitter's Writer.
+// Model, expand and flatten code for the block is also generated.
 func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a tfsdk.Block or Schema's Blocks (map[string]tfsdk.Block).
-	fprintf(e.SchemaWriter, "{\n")
+	var nestingMode string
 
 	switch v := property.Type; v {
 	//
 	// Complex types.
 	//
 	case schema.TypeList:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+		nestingMode = "List"
 
-			if err != nil {
-				return err
-			}
+	case schema.TypeSet:
+		nestingMode = "Set"
 
-			fprintf(e.SchemaWriter, "NestingMode:tfsdk.BlockNestingModeList,\n")
+	default:
+		return unsupportedTypeError(path, v.String())
+	}
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
-		}
+	resource, ok := property.Elem.(*schema.Resource)
 
-	case schema.TypeSet:
-		switch v := property.Elem.(type) {
-		case *schema.Resource:
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+	if !ok {
+		return unsupportedTypeError(path, fmt.Sprintf("(Block) %s of %T", strings.ToLower(nestingMode), property.Elem))
+	}
 
-			if err != nil {
-				return err
-			}
+	// At this point we are emitting code for the values of a tfsdk.Block or Schema's Blocks (map[string]tfsdk.Block).
+	fprintf(e.SchemaWriter, "{\n")
 
-			fprintf(e.SchemaWriter, "NestingMode:tfsdk.BlockNestingModeSet,\n")
+	// Reserve this block's place so that model code is generated in schema order.
+	i := len(e.flex)
+	e.flex = append(e.flex, "")
 
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
-		}
+	m, err := e.emitAttributesAndBlocks(path, resource.Schema, nil)
 
-	default:
-		return unsupportedTypeError(path, v.String())
+	if err != nil {
+		return err
 	}
 
+	e.flex[i] = e.blockFlex(path, nestingMode, m)
+
+	fprintf(e.SchemaWriter, "NestingMode:tfsdk.BlockNestingMode%s,\n", nestingMode)
+
 	// Compatibility hacks.
 	// See Schema::coreConfigSchemaBlock.
 	if property.Required && property.MinItems == 0 {
@@ -578,6 +820,12 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		fprintf(e.SchemaWriter, "DeprecationMessage:%q,\n", deprecationMessage)
 	}
 
+	if property.ForceNew && !e.IsDataSource {
+		fprintf(e.SchemaWriter, "PlanModifiers:tfsdk.AttributePlanModifiers{\n")
+		fprintf(e.SchemaWriter, "resource.RequiresReplace(),\n")
+		fprintf(e.SchemaWriter, "},\n")
+	}
+
 	if def := property.Default; def != nil {
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
@@ -587,127 +835,163 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
-// emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-// Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitComputedOnlyBlock(path []string, schema map[string]*schema.Schema) error {
-	names := make([]string, 0)
-	for name := range schema {
-		names = append(names, name)
+// blockFlex returns the generated model struct, attribute types and expand and flatten stubs for a Plugin SDK Block.
+func (e *emitter) blockFlex(path []string, nestingMode string, m *model) string {
+	e.ImportFrameworkAttr = true
+
+	blockName := e.blockName(path)
+	expandFuncName := e.funcName("expand", path)
+	flattenFuncName := e.funcName("flatten", path)
+	valueType := "types." + nestingMode
+	sb := strings.Builder{}
+
+	fprintf(&sb, "type %sData struct {\n", blockName)
+	fprintf(&sb, "%s", m.fields.String())
+	fprintf(&sb, "}\n\n")
+
+	fprintf(&sb, "var %sAttrTypes = map[string]attr.Type{\n", blockName)
+	fprintf(&sb, "%s", m.attrTypes.String())
+	fprintf(&sb, "}\n\n")
+
+	fprintf(&sb, "// %s expands the %q block.\n", expandFuncName, strings.Join(path, "."))
+	fprintf(&sb, "// TODO Return the AWS API type.\n")
+	fprintf(&sb, "func %s(ctx context.Context, tf%s %s) ([]interface{}, diag.Diagnostics) {\n", expandFuncName, nestingMode, valueType)
+	fprintf(&sb, "var diags diag.Diagnostics\n\n")
+	fprintf(&sb, "if tf%[1]s.IsNull() || tf%[1]s.IsUnknown() {\n", nestingMode)
+	fprintf(&sb, "return nil, diags\n")
+	fprintf(&sb, "}\n\n")
+	fprintf(&sb, "var data []%sData\n\n", blockName)
+	fprintf(&sb, "diags.Append(tf%s.ElementsAs(ctx, &data, false)...)\n\n", nestingMode)
+	fprintf(&sb, "if diags.HasError() {\n")
+	fprintf(&sb, "return nil, diags\n")
+	fprintf(&sb, "}\n\n")
+	fprintf(&sb, "apiObjects := make([]interface{}, 0, len(data))\n\n")
+	fprintf(&sb, "for _, v := range data {\n")
+	fprintf(&sb, "// TODO Expand v into the AWS API type.\n")
+	for _, name := range m.nestedBlocks {
+		fprintf(&sb, "// Expand the nested %q block with %s(ctx, v.%s).\n", name, e.funcName("expand", append(path[:len(path):len(path)], name)), naming.ToCamelCase(name))
 	}
-	sort.Strings(names)
-
-	fprintf(e.SchemaWriter, "types.ObjectType{\n")
+	fprintf(&sb, "apiObjects = append(apiObjects, v)\n")
+	fprintf(&sb, "}\n\n")
+	fprintf(&sb, "return apiObjects, diags\n")
+	fprintf(&sb, "}\n\n")
+
+	fprintf(&sb, "// %s flattens the %q block.\n", flattenFuncName, strings.Join(path, "."))
+	fprintf(&sb, "// TODO Accept the AWS API type.\n")
+	fprintf(&sb, "func %s(ctx context.Context, apiObjects []interface{}) %s {\n", flattenFuncName, valueType)
+	fprintf(&sb, "elemType := types.ObjectType{AttrTypes:%sAttrTypes}\n\n", blockName)
+	fprintf(&sb, "if apiObjects == nil {\n")
+	fprintf(&sb, "return %s{ElemType:elemType,Null:true}\n", valueType)
+	fprintf(&sb, "}\n\n")
+	fprintf(&sb, "elems := make([]attr.Value, 0, len(apiObjects))\n\n")
+	fprintf(&sb, "for range apiObjects {\n")
+	fprintf(&sb, "// TODO Flatten the AWS API type.\n")
+	fprintf(&sb, "elems = append(elems, types.Object{\n")
+	fprintf(&sb, "AttrTypes:%sAttrTypes,\n", blockName)
+	fprintf(&sb, "Attrs:map[string]attr.Value{\n")
+	fprintf(&sb, "%s", m.attrValues.String())
+	fprintf(&sb, "},\n")
+	fprintf(&sb, "})\n")
+	fprintf(&sb, "}\n\n")
+	fprintf(&sb, "return %s{ElemType:elemType,Elems:elems}\n", valueType)
+	fprintf(&sb, "}\n")
+
+	return sb.String()
+}
 
-	emittedFieldName := false
-	for _, name := range names {
-		property := schema[name]
+// emitTimeouts generates the Plugin Framework code for a Plugin SDK Resource's Timeouts
+// and emits the generated code to the emitter's Writer.
+// Default timeout constants and a method returning each configured timeout are also generated.
+// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/helper/schema/core_schema.go#L310.
+func (e *emitter) emitTimeouts(timeouts *schema.ResourceTimeout) {
+	type timeout struct {
+		name     string
+		duration *time.Duration
+	}
 
-		if !emittedFieldName {
-			fprintf(e.SchemaWriter, "AttrTypes: map[string]attr.Type{\n")
-			emittedFieldName = true
-			e.ImportFrameworkAttr = true
+	var operations []timeout
+	for _, v := range []timeout{
+		{schema.TimeoutCreate, timeouts.Create},
+		{schema.TimeoutRead, timeouts.Read},
+		{schema.TimeoutUpdate, timeouts.Update},
+		{schema.TimeoutDelete, timeouts.Delete},
+		{schema.TimeoutDefault, timeouts.Default},
+	} {
+		if v.duration != nil {
+			operations = append(operations, v)
 		}
+	}
 
-		fprintf(e.SchemaWriter, "%q:", name)
-
-		err := e.emitComputedOnlyBlockProperty(append(path, name), property)
+	e.ImportProviderFrameworkTypes = true
+	e.ImportTime = true
 
-		if err != nil {
-			return err
-		}
-	}
-	if emittedFieldName {
+	fprintf(e.SchemaWriter, "%q:{\n", schema.TimeoutsConfigKey)
+	fprintf(e.SchemaWriter, "Attributes: map[string]tfsdk.Attribute{\n")
+	for _, v := range operations {
+		fprintf(e.SchemaWriter, "%q:{\n", v.name)
+		fprintf(e.SchemaWriter, "Type:fwtypes.DurationType,\n")
+		fprintf(e.SchemaWriter, "Optional:true,\n")
 		fprintf(e.SchemaWriter, "},\n")
 	}
+	fprintf(e.SchemaWriter, "},\n")
+	fprintf(e.SchemaWriter, "NestingMode:tfsdk.BlockNestingModeSingle,\n")
+	fprintf(e.SchemaWriter, "},\n")
 
-	fprintf(e.SchemaWriter, "}")
-
-	return nil
-}
+	sb := strings.Builder{}
 
-// emitComputedOnlyBlockProperty generates the Plugin Framework code for a Plugin SDK Computed-only nested block's property
-// and emits the generated code to the emitter's Writer.
-// See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
-func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.Schema) error {
-	// At this point we are emitting code for the values of a types.ObjectType's AttrMap (map[string]attr.Type).
-	switch v := property.Type; v {
-	//
-	// Primitive types.
-	//
-	case schema.TypeBool:
-		fprintf(e.SchemaWriter, "types.BoolType,\n")
-
-	case schema.TypeFloat:
-		fprintf(e.SchemaWriter, "types.Float64Type,\n")
-
-	case schema.TypeInt:
-		fprintf(e.SchemaWriter, "types.Int64Type,\n")
-
-	case schema.TypeString:
-		fprintf(e.SchemaWriter, "types.StringType,\n")
-
-	//
-	// Complex types.
-	//
-	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateType, typeName string
+	fprintf(&sb, "// Default operation timeouts.\n")
+	fprintf(&sb, "const (\n")
+	for _, v := range operations {
+		fprintf(&sb, "%s%sTimeout = %s\n", e.Prefix, naming.ToCamelCase(v.name), durationLiteral(*v.duration))
+	}
+	fprintf(&sb, ")\n\n")
 
-		switch v {
-		case schema.TypeList:
-			aggregateType = "types.ListType"
-			typeName = "list"
-		case schema.TypeMap:
-			aggregateType = "types.MapType"
-			typeName = "map"
-		case schema.TypeSet:
-			aggregateType = "types.SetType"
-			typeName = "set"
+	fprintf(&sb, "type %sTimeoutsData struct {\n", e.Prefix)
+	for _, v := range operations {
+		fprintf(&sb, "%s fwtypes.Duration `tfsdk:%q`\n", naming.ToCamelCase(v.name), v.name)
+	}
+	fprintf(&sb, "}\n")
+
+	for _, v := range operations {
+		fieldName := naming.ToCamelCase(v.name)
+		defaultName := fmt.Sprintf("%s%sTimeout", e.Prefix, fieldName)
+
+		fprintf(&sb, "\n// %sTimeout returns the configured %q timeout, or its default.\n", v.name, v.name)
+		fprintf(&sb, "func (data *%sData) %sTimeout(ctx context.Context) (time.Duration, diag.Diagnostics) {\n", e.Prefix, v.name)
+		fprintf(&sb, "var diags diag.Diagnostics\n\n")
+		fprintf(&sb, "if data.Timeouts.IsNull() || data.Timeouts.IsUnknown() {\n")
+		fprintf(&sb, "return %s, diags\n", defaultName)
+		fprintf(&sb, "}\n\n")
+		fprintf(&sb, "var timeouts %sTimeoutsData\n\n", e.Prefix)
+		fprintf(&sb, "diags.Append(data.Timeouts.As(ctx, &timeouts, types.ObjectAsOptions{})...)\n\n")
+		fprintf(&sb, "if diags.HasError() {\n")
+		fprintf(&sb, "return 0, diags\n")
+		fprintf(&sb, "}\n\n")
+		fprintf(&sb, "if v := timeouts.%s; !v.IsNull() && !v.IsUnknown() {\n", fieldName)
+		fprintf(&sb, "return v.Value, diags\n")
+		fprintf(&sb, "}\n\n")
+		if v.name != schema.TimeoutDefault && timeouts.Default != nil {
+			fprintf(&sb, "if v := timeouts.%s; !v.IsNull() && !v.IsUnknown() {\n", naming.ToCamelCase(schema.TimeoutDefault))
+			fprintf(&sb, "return v.Value, diags\n")
+			fprintf(&sb, "}\n\n")
 		}
+		fprintf(&sb, "return %s, diags\n", defaultName)
+		fprintf(&sb, "}\n")
+	}
 
-		switch v := property.Elem.(type) {
-		case *schema.Schema:
-			var elementType string
-
-			switch v := v.Type; v {
-			case schema.TypeBool:
-				elementType = "types.BoolType"
-
-			case schema.TypeFloat:
-				elementType = "types.Float64Type"
-
-			case schema.TypeInt:
-				elementType = "types.Int64Type"
-
-			case schema.TypeString:
-				elementType = "types.StringType"
-
-			default:
-				return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %s", typeName, v.String()))
-			}
-
-			fprintf(e.SchemaWriter, "%s{ElemType:%s},\n", aggregateType, elementType)
-
-		case *schema.Resource:
-			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
-			fprintf(e.SchemaWriter, "%s{ElemType:", aggregateType)
-
-			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
-				return err
-			}
-
-			fprintf(e.SchemaWriter, "},\n")
-
-		default:
-			return unsupportedTypeError(path, fmt.Sprintf("(ComputedOnlyBlockProperty) %s of %T", typeName, v))
-		}
+	e.flex = append(e.flex, sb.String())
+}
 
-	default:
-		return unsupportedTypeError(path, v.String())
-	}
+// blockName returns the base name of the generated model code for the Block at the specified path,
+// e.g. resourceInstanceRootBlockDevice.
+func (e *emitter) blockName(path []string) string {
+	return e.Prefix + naming.ToCamelCase(strings.Join(path, "_"))
+}
 
-	return nil
+// funcName returns the name of the generated function with the specified verb for the Block at the specified path,
+// e.g. expandResourceInstanceRootBlockDevice.
+func (e *emitter) funcName(verb string, path []string) string {
+	return verb + naming.ToCamelCase(e.blockName(path))
 }
 
 // warnf emits a formatted warning message to the UI.
@@ -720,6 +1004,29 @@ func fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
 	return io.WriteString(w, fmt.Sprintf(format, a...))
 }
 
+// primitiveType returns the Plugin Framework type for the specified primitive value type.
+func primitiveType(valueType string) fwType {
+	return fwType{
+		attrType:  valueType + "Type",
+		valueType: valueType,
+		nullValue: valueType + "{Null:true}",
+	}
+}
+
+// durationLiteral returns Go source code for the specified duration.
+func durationLiteral(d time.Duration) string {
+	switch {
+	case d != 0 && d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d != 0 && d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d != 0 && d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
+	}
+}
+
 // isAttribute returns whether or not the specified property should be emitted as an Attribute (vs. a Block).
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/helper/schema/core_schema.go#L57.
 func isAttribute(property *schema.Schema) bool {
@@ -758,19 +1065,30 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
-	EmitResourceImportState      bool
-	EmitResourceUpdateSkeleton   bool
-	ImportFrameworkAttr          bool
-	ImportProviderFrameworkTypes bool
-	Name                         string // e.g. Instance
-	PackageName                  string // e.g. ec2
-	Schema                       string
-	Struct                       string
-	TFTypeName                   string // e.g. aws_instance
+	EmitResourceImportState              bool
+	EmitResourceUpdateSkeleton           bool
+	Flex                                 string
+	ImportFrameworkAttr                  bool
+	ImportProviderFrameworkPlanModifiers bool
+	ImportProviderFrameworkTypes         bool
+	ImportTime                           bool
+	Name                                 string // e.g. Instance
+	PackageName                          string // e.g. ec2
+	SDKFactoryName                       string // e.g. ResourceInstance
+	Schema                               string
+	StateUpgraders                       string
+	Struct                               string
+	TFTypeName                           string // e.g. aws_instance
 }
 
 //go:embed datasource.tmpl
 var datasourceImpl string
 
+//go:embed datasource_test.tmpl
+var datasourceTestImpl string
+
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed resource_test.tmpl
+var resourceTestImpl string